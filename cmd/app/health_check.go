@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/logger"
+)
+
+// healthCheckTimeout bounds the readiness request, below the Docker HEALTHCHECK timeout
+const healthCheckTimeout = 2 * time.Second
+
+// runHealthCheck asks the readiness endpoint of the instance running in the same
+// container, for images without curl or wget, and returns the process exit code
+func runHealthCheck(configPath config.Path) int {
+	log, err := logger.NewWithOptions(logger.Options{Level: "error", Output: logger.OutputStderr})
+	if err != nil {
+		log = logger.New()
+	}
+
+	cfg, err := config.Load(log, configPath)
+	if err != nil {
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("http://%s/readyz", net.JoinHostPort(healthCheckHost(cfg.Server.Host), cfg.Server.Port))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.Error().Err(err).Str("url", url).Msg("Failed to build health check request")
+		return 1
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Error().Err(err).Str("url", url).Msg("Health check request failed")
+		return 1
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Error().Int("status", resp.StatusCode).Str("url", url).Msg("Service is not ready")
+		return 1
+	}
+	return 0
+}
+
+// healthCheckHost returns the address to reach a server bound to host, the
+// loopback address when it listens on all interfaces
+func healthCheckHost(host string) string {
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		return "127.0.0.1"
+	}
+	return host
+}
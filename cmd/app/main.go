@@ -12,15 +12,29 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+
+	"github.com/go-clean/platform/config"
+	"github.com/gofiber/fiber/v2"
 )
 
 func main() {
+	configPath := flag.String("config", "", "path of the configuration file, overrides "+config.ConfigEnvVar)
+	healthCheck := flag.Bool("health-check", false, "check the readiness of the running instance and exit, for container health checks")
+	flag.Parse()
+
+	if *healthCheck {
+		os.Exit(runHealthCheck(config.Path(*configPath)))
+	}
+
 	// Initialize application with wire-generated dependency injection
-	app, err := InitializeApplication()
+	app, err := InitializeApplication(config.Path(*configPath))
 	if err != nil {
 		log.Fatalf("Failed to initialize application: %v", err)
 	}
@@ -35,11 +49,37 @@ func main() {
 	app.Logger.Info().Msg("Registering routes")
 	app.Probes.PingHandler.RegisterRoutes(fiberApp)
 	app.Probes.HealthHandler.RegisterRoutes(fiberApp)
+	app.Probes.InfoHandler.RegisterRoutes(fiberApp)
 	app.Swagger.DocsHandler.RegisterRoutes(fiberApp, app.Config.Swagger.Enabled)
+	app.Metrics.RegisterRoutes(fiberApp)
+	app.Admin.LogLevelHandler.RegisterRoutes(fiberApp)
 	app.Logger.Info().Msg("Routes registered successfully")
 
+	// Start background health refresh (no-op unless enabled)
+	app.Probes.HealthPoller.Start()
+
+	// Raise the log level temporarily on SIGHUP
+	app.Admin.LogLevelSignalHandler.Start()
+
+	// Mark the service ready once the HTTP listener is up
+	fiberApp.Hooks().OnListen(func(fiber.ListenData) error {
+		return app.Probes.LifecycleService.MarkReady(context.Background())
+	})
+
+	// Start gRPC server with the standard health checking service when enabled
+	if app.GRPCServer.Enabled() {
+		app.Probes.HealthServer.RegisterServices(app.GRPCServer.GetServer())
+		app.Probes.HealthServer.Start()
+		app.Logger.Info().Str("port", app.Config.GRPC.Port).Msg("Starting gRPC server")
+		go func() {
+			if err := app.GRPCServer.Start(); err != nil {
+				app.Logger.Fatal().Err(err).Msg("Failed to start gRPC server")
+			}
+		}()
+	}
+
 	// Start server
-	app.Logger.Info().Str("port", app.Config.Server.Port).Msg("Starting HTTP server")
+	app.Logger.Info().Str("host", app.Config.Server.Host).Str("port", app.Config.Server.Port).Msg("Starting HTTP server")
 	go func() {
 		if err := app.HTTPServer.Start(); err != nil {
 			app.Logger.Fatal().Err(err).Msg("Failed to start HTTP server")
@@ -53,10 +93,38 @@ func main() {
 
 	app.Logger.Info().Msg("Shutting down server...")
 
+	// Fail the readiness probe and keep serving in-flight traffic while load balancers deregister the pod
+	if err := app.Probes.LifecycleService.StartDraining(context.Background()); err != nil {
+		app.Logger.Error().Err(err).Msg("Failed to start draining")
+	}
+	// Tell gRPC health watchers about the readiness change without waiting for the next watch interval
+	if app.GRPCServer.Enabled() {
+		app.Probes.HealthServer.Refresh()
+	}
+	app.Logger.Info().Dur("drain_period_ms", app.Config.Server.DrainPeriod).Msg("Draining before closing the listener")
+	time.Sleep(app.Config.Server.DrainPeriod)
+
+	// Close gRPC health watch streams, they would otherwise block the graceful stop
+	if app.GRPCServer.Enabled() {
+		app.Probes.HealthServer.Stop()
+	}
+
 	// Gracefully shutdown the server
 	if err := app.HTTPServer.Shutdown(); err != nil {
 		app.Logger.Error().Err(err).Msg("Server forced to shutdown")
 	}
+	if app.GRPCServer.Enabled() {
+		app.GRPCServer.Shutdown()
+	}
+	app.Probes.HealthPoller.Stop()
+	app.Admin.LogLevelSignalHandler.Stop()
+
+	// Flush spans that are still buffered in the exporter
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := app.Tracing.Shutdown(ctx); err != nil {
+		app.Logger.Error().Err(err).Msg("Failed to shutdown tracing")
+	}
 
 	app.Logger.Info().Msg("Server exited")
 }
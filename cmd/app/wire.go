@@ -4,14 +4,24 @@
 package main
 
 import (
+	"github.com/go-clean/internal/admin"
+	adminHttp "github.com/go-clean/internal/admin/presentation/http"
+	adminSignal "github.com/go-clean/internal/admin/presentation/signal"
 	"github.com/go-clean/internal/probes"
+	probesCommand "github.com/go-clean/internal/probes/application/command"
+	probesQuery "github.com/go-clean/internal/probes/application/query"
+	probesPorts "github.com/go-clean/internal/probes/ports"
+	probesGrpc "github.com/go-clean/internal/probes/presentation/grpc"
 	probesHttp "github.com/go-clean/internal/probes/presentation/http"
 	"github.com/go-clean/internal/swagger"
 	swaggerHttp "github.com/go-clean/internal/swagger/presentation/http"
 	"github.com/go-clean/platform"
 	"github.com/go-clean/platform/config"
+	platformGrpc "github.com/go-clean/platform/grpc"
 	"github.com/go-clean/platform/http"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/metrics"
+	"github.com/go-clean/platform/tracing"
 	"github.com/google/wire"
 )
 
@@ -20,14 +30,22 @@ type Application struct {
 	Config     *config.Config
 	Logger     logger.Logger
 	HTTPServer *http.Server
+	GRPCServer *platformGrpc.Server
+	Metrics    *metrics.Registry
+	Tracing    *tracing.Provider
 	Probes     *ProbesModule
 	Swagger    *SwaggerModule
+	Admin      *AdminModule
 }
 
 // ProbesModule holds all probes-related dependencies
 type ProbesModule struct {
-	PingHandler   *probesHttp.PingHandler
-	HealthHandler *probesHttp.HealthHandler
+	PingHandler      *probesHttp.PingHandler
+	HealthHandler    *probesHttp.HealthHandler
+	InfoHandler      *probesHttp.InfoHandler
+	LifecycleService *probesCommand.LifecycleService
+	HealthPoller     *probesQuery.HealthPoller
+	HealthServer     *probesGrpc.HealthServer
 }
 
 // SwaggerModule holds all swagger-related dependencies
@@ -35,8 +53,14 @@ type SwaggerModule struct {
 	DocsHandler *swaggerHttp.DocsHandler
 }
 
+// AdminModule holds all admin-related dependencies
+type AdminModule struct {
+	LogLevelHandler       *adminHttp.LogLevelHandler
+	LogLevelSignalHandler *adminSignal.LogLevelSignalHandler
+}
+
 // InitializeApplication creates and initializes the application with all dependencies
-func InitializeApplication() (*Application, error) {
+func InitializeApplication(configPath config.Path) (*Application, error) {
 	wire.Build(
 		// Platform providers
 		platform.PlatformSet,
@@ -44,23 +68,47 @@ func InitializeApplication() (*Application, error) {
 		// Internal module providers
 		probes.ProbesSet,
 		swagger.SwaggerSet,
+		admin.AdminSet,
+
+		// Cross-module contributions
+		ProvideHealthCheckers,
 
 		// Application structure providers
 		ProvideProbesModule,
 		ProvideSwaggerModule,
+		ProvideAdminModule,
 		ProvideApplication,
 	)
 	return &Application{}, nil
 }
 
+// ProvideHealthCheckers aggregates the health checkers each module's Wire set
+// contributes. A module reporting its own dependencies provides a HealthCheckers
+// slice type of its own, added as a parameter here.
+func ProvideHealthCheckers(
+	probesCheckers probes.HealthCheckers,
+) []probesPorts.HealthChecker {
+	var checkers []probesPorts.HealthChecker
+	checkers = append(checkers, probesCheckers...)
+	return checkers
+}
+
 // ProvideProbesModule provides the probes module
 func ProvideProbesModule(
 	pingHandler *probesHttp.PingHandler,
 	healthHandler *probesHttp.HealthHandler,
+	infoHandler *probesHttp.InfoHandler,
+	lifecycleService *probesCommand.LifecycleService,
+	healthPoller *probesQuery.HealthPoller,
+	healthServer *probesGrpc.HealthServer,
 ) *ProbesModule {
 	return &ProbesModule{
-		PingHandler:   pingHandler,
-		HealthHandler: healthHandler,
+		PingHandler:      pingHandler,
+		HealthHandler:    healthHandler,
+		InfoHandler:      infoHandler,
+		LifecycleService: lifecycleService,
+		HealthPoller:     healthPoller,
+		HealthServer:     healthServer,
 	}
 }
 
@@ -73,19 +121,38 @@ func ProvideSwaggerModule(
 	}
 }
 
+// ProvideAdminModule provides the admin module
+func ProvideAdminModule(
+	logLevelHandler *adminHttp.LogLevelHandler,
+	logLevelSignalHandler *adminSignal.LogLevelSignalHandler,
+) *AdminModule {
+	return &AdminModule{
+		LogLevelHandler:       logLevelHandler,
+		LogLevelSignalHandler: logLevelSignalHandler,
+	}
+}
+
 // ProvideApplication provides the main application structure
 func ProvideApplication(
 	config *config.Config,
 	logger logger.Logger,
 	httpServer *http.Server,
+	grpcServer *platformGrpc.Server,
+	metricsRegistry *metrics.Registry,
+	tracingProvider *tracing.Provider,
 	probesModule *ProbesModule,
 	swaggerModule *SwaggerModule,
+	adminModule *AdminModule,
 ) *Application {
 	return &Application{
 		Config:     config,
 		Logger:     logger,
 		HTTPServer: httpServer,
+		GRPCServer: grpcServer,
+		Metrics:    metricsRegistry,
+		Tracing:    tracingProvider,
 		Probes:     probesModule,
 		Swagger:    swaggerModule,
+		Admin:      adminModule,
 	}
 }
@@ -7,44 +7,96 @@
 package main
 
 import (
+	"github.com/go-clean/internal/admin"
+	http4 "github.com/go-clean/internal/admin/presentation/http"
+	"github.com/go-clean/internal/admin/presentation/signal"
 	"github.com/go-clean/internal/probes"
+	"github.com/go-clean/internal/probes/application/command"
+	"github.com/go-clean/internal/probes/application/query"
+	"github.com/go-clean/internal/probes/ports"
+	grpc2 "github.com/go-clean/internal/probes/presentation/grpc"
 	http2 "github.com/go-clean/internal/probes/presentation/http"
 	"github.com/go-clean/internal/swagger"
 	http3 "github.com/go-clean/internal/swagger/presentation/http"
 	"github.com/go-clean/platform"
 	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/grpc"
 	"github.com/go-clean/platform/http"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/metrics"
+	"github.com/go-clean/platform/tracing"
 )
 
 // Injectors from wire.go:
 
 // InitializeApplication creates and initializes the application with all dependencies
-func InitializeApplication() (*Application, error) {
+func InitializeApplication(configPath config.Path) (*Application, error) {
 	logger := platform.ProvideLogger()
-	config, err := platform.ProvideConfig(logger)
+	configConfig, err := platform.ProvideConfig(logger, configPath)
 	if err != nil {
 		return nil, err
 	}
-	server := platform.ProvideHTTPServer(config, logger)
+	registry, err := platform.ProvideMetrics(configConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := platform.ProvideTracing(configConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	client, err := platform.ProvideRedis(configConfig, logger, registry)
+	if err != nil {
+		return nil, err
+	}
+	store := platform.ProvideRateLimitStore(configConfig, client, logger)
+	server := platform.ProvideHTTPServer(configConfig, logger, registry, provider, store)
+	grpcServer := platform.ProvideGRPCServer(configConfig, logger)
 	pingQueryHandler := probes.ProvidePingQueryHandler(logger)
 	pingHandler := probes.ProvidePingHandler(logger, pingQueryHandler)
-	pool, err := platform.ProvideDatabase(config, logger)
+	healthHandlerConfig := probes.ProvideHealthHandlerConfig(configConfig)
+	pool, err := platform.ProvideDatabase(configConfig, logger, registry)
+	if err != nil {
+		return nil, err
+	}
+	databaseChecker := probes.ProvideDatabaseChecker(logger, pool, configConfig)
+	redisChecker := probes.ProvideRedisChecker(logger, client, configConfig)
+	healthCheckers := probes.ProvideHealthCheckers(databaseChecker, redisChecker)
+	v := ProvideHealthCheckers(healthCheckers)
+	healthCheckerRegistry, err := probes.ProvideHealthCheckerRegistry(logger, v)
 	if err != nil {
 		return nil, err
 	}
-	databaseChecker := probes.ProvideDatabaseChecker(logger, pool)
-	client, err := platform.ProvideRedis(config, logger)
+	healthQueryConfig := probes.ProvideHealthQueryConfig(configConfig)
+	healthMetricsRecorder, err := probes.ProvideHealthMetricsRecorder(registry)
 	if err != nil {
 		return nil, err
 	}
-	redisChecker := probes.ProvideRedisChecker(logger, client)
-	getHealthQueryHandler := probes.ProvideHealthQueryHandler(logger, databaseChecker, redisChecker)
-	healthService := probes.ProvideHealthService(logger, getHealthQueryHandler)
-	getLivenessQueryHandler := probes.ProvideLivenessQueryHandler(logger)
+	getHealthQueryHandler := probes.ProvideHealthQueryHandler(logger, healthCheckerRegistry, healthQueryConfig, healthMetricsRecorder)
+	healthPollerConfig := probes.ProvideHealthPollerConfig(configConfig)
+	watchdog := platform.ProvideWatchdog(logger)
+	portsWatchdog := probes.ProvideWatchdog(watchdog)
+	healthPoller := probes.ProvideHealthPoller(logger, getHealthQueryHandler, healthPollerConfig, portsWatchdog)
+	healthService := probes.ProvideHealthService(logger, getHealthQueryHandler, healthPoller)
+	livenessQueryConfig := probes.ProvideLivenessQueryConfig(configConfig)
+	getLivenessQueryHandler := probes.ProvideLivenessQueryHandler(logger, livenessQueryConfig, portsWatchdog)
 	livenessService := probes.ProvideLivenessService(logger, getLivenessQueryHandler)
-	healthHandler := probes.ProvideHealthHandler(logger, healthService, livenessService)
-	probesModule := ProvideProbesModule(pingHandler, healthHandler)
+	lifecycle := probes.ProvideLifecycle()
+	getReadinessQueryHandler := probes.ProvideReadinessQueryHandler(logger, lifecycle, healthService)
+	readinessService := probes.ProvideReadinessService(logger, getReadinessQueryHandler)
+	getStartupQueryHandler := probes.ProvideStartupQueryHandler(logger, lifecycle)
+	startupService := probes.ProvideStartupService(logger, getStartupQueryHandler)
+	healthHandler := probes.ProvideHealthHandler(logger, healthHandlerConfig, healthService, livenessService, readinessService, startupService)
+	infoQueryConfig := probes.ProvideInfoQueryConfig(configConfig)
+	buildInfoProvider := probes.ProvideBuildInfoProvider()
+	getInfoQueryHandler := probes.ProvideInfoQueryHandler(logger, infoQueryConfig, buildInfoProvider)
+	infoService := probes.ProvideInfoService(logger, getInfoQueryHandler)
+	infoHandler := probes.ProvideInfoHandler(logger, infoService)
+	markReadyCommandHandler := probes.ProvideMarkReadyCommandHandler(logger, lifecycle)
+	startDrainingCommandHandler := probes.ProvideStartDrainingCommandHandler(logger, lifecycle)
+	lifecycleService := probes.ProvideLifecycleService(logger, markReadyCommandHandler, startDrainingCommandHandler)
+	healthServerConfig := probes.ProvideHealthServerConfig(configConfig)
+	healthServer := probes.ProvideHealthServer(logger, healthServerConfig, healthService, livenessService, readinessService, portsWatchdog)
+	probesModule := ProvideProbesModule(pingHandler, healthHandler, infoHandler, lifecycleService, healthPoller, healthServer)
 	swaggerConfig := swagger.ProvideSwaggerConfig()
 	swaggerLoader, err := swagger.ProvideSwaggerLoader(logger, swaggerConfig)
 	if err != nil {
@@ -53,7 +105,22 @@ func InitializeApplication() (*Application, error) {
 	swaggerQueryHandler := swagger.ProvideSwaggerQueryHandler(logger, swaggerLoader)
 	docsHandler := swagger.ProvideDocsHandler(logger, swaggerQueryHandler)
 	swaggerModule := ProvideSwaggerModule(docsHandler)
-	application := ProvideApplication(config, logger, server, probesModule, swaggerModule)
+	logLevelHandlerConfig := admin.ProvideLogLevelHandlerConfig(configConfig)
+	levelController, err := platform.ProvideLevelController(logger)
+	if err != nil {
+		return nil, err
+	}
+	logLevelController := admin.ProvideLogLevelController(levelController)
+	getLogLevelsQueryHandler := admin.ProvideGetLogLevelsQueryHandler(logger, logLevelController)
+	logLevelsService := admin.ProvideLogLevelsService(logger, getLogLevelsQueryHandler)
+	setLogLevelCommandHandler := admin.ProvideSetLogLevelCommandHandler(logger, logLevelController)
+	resetLogLevelCommandHandler := admin.ProvideResetLogLevelCommandHandler(logger, logLevelController)
+	logLevelService := admin.ProvideLogLevelService(logger, setLogLevelCommandHandler, resetLogLevelCommandHandler)
+	logLevelHandler := admin.ProvideLogLevelHandler(logger, logLevelHandlerConfig, logLevelsService, logLevelService)
+	logLevelSignalConfig := admin.ProvideLogLevelSignalConfig(configConfig)
+	logLevelSignalHandler := admin.ProvideLogLevelSignalHandler(logger, logLevelSignalConfig, logLevelService)
+	adminModule := ProvideAdminModule(logLevelHandler, logLevelSignalHandler)
+	application := ProvideApplication(configConfig, logger, server, grpcServer, registry, provider, probesModule, swaggerModule, adminModule)
 	return application, nil
 }
 
@@ -64,14 +131,22 @@ type Application struct {
 	Config     *config.Config
 	Logger     logger.Logger
 	HTTPServer *http.Server
+	GRPCServer *grpc.Server
+	Metrics    *metrics.Registry
+	Tracing    *tracing.Provider
 	Probes     *ProbesModule
 	Swagger    *SwaggerModule
+	Admin      *AdminModule
 }
 
 // ProbesModule holds all probes-related dependencies
 type ProbesModule struct {
-	PingHandler   *http2.PingHandler
-	HealthHandler *http2.HealthHandler
+	PingHandler      *http2.PingHandler
+	HealthHandler    *http2.HealthHandler
+	InfoHandler      *http2.InfoHandler
+	LifecycleService *command.LifecycleService
+	HealthPoller     *query.HealthPoller
+	HealthServer     *grpc2.HealthServer
 }
 
 // SwaggerModule holds all swagger-related dependencies
@@ -79,14 +154,39 @@ type SwaggerModule struct {
 	DocsHandler *http3.DocsHandler
 }
 
+// AdminModule holds all admin-related dependencies
+type AdminModule struct {
+	LogLevelHandler       *http4.LogLevelHandler
+	LogLevelSignalHandler *signal.LogLevelSignalHandler
+}
+
+// ProvideHealthCheckers aggregates the health checkers each module's Wire set
+// contributes. A module reporting its own dependencies provides a HealthCheckers
+// slice type of its own, added as a parameter here.
+func ProvideHealthCheckers(
+	probesCheckers probes.HealthCheckers,
+) []ports.HealthChecker {
+	var checkers []ports.HealthChecker
+	checkers = append(checkers, probesCheckers...)
+	return checkers
+}
+
 // ProvideProbesModule provides the probes module
 func ProvideProbesModule(
 	pingHandler *http2.PingHandler,
 	healthHandler *http2.HealthHandler,
+	infoHandler *http2.InfoHandler,
+	lifecycleService *command.LifecycleService,
+	healthPoller *query.HealthPoller,
+	healthServer *grpc2.HealthServer,
 ) *ProbesModule {
 	return &ProbesModule{
-		PingHandler:   pingHandler,
-		HealthHandler: healthHandler,
+		PingHandler:      pingHandler,
+		HealthHandler:    healthHandler,
+		InfoHandler:      infoHandler,
+		LifecycleService: lifecycleService,
+		HealthPoller:     healthPoller,
+		HealthServer:     healthServer,
 	}
 }
 
@@ -99,18 +199,37 @@ func ProvideSwaggerModule(
 	}
 }
 
+// ProvideAdminModule provides the admin module
+func ProvideAdminModule(
+	logLevelHandler *http4.LogLevelHandler,
+	logLevelSignalHandler *signal.LogLevelSignalHandler,
+) *AdminModule {
+	return &AdminModule{
+		LogLevelHandler:       logLevelHandler,
+		LogLevelSignalHandler: logLevelSignalHandler,
+	}
+}
+
 // ProvideApplication provides the main application structure
 func ProvideApplication(config2 *config.Config, logger2 logger.Logger,
 
 	httpServer *http.Server,
+	grpcServer *grpc.Server,
+	metricsRegistry *metrics.Registry,
+	tracingProvider *tracing.Provider,
 	probesModule *ProbesModule,
 	swaggerModule *SwaggerModule,
+	adminModule *AdminModule,
 ) *Application {
 	return &Application{
 		Config:     config2,
 		Logger:     logger2,
 		HTTPServer: httpServer,
+		GRPCServer: grpcServer,
+		Metrics:    metricsRegistry,
+		Tracing:    tracingProvider,
 		Probes:     probesModule,
 		Swagger:    swaggerModule,
+		Admin:      adminModule,
 	}
 }
@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-clean/internal/admin/domain"
+	"github.com/go-clean/internal/admin/ports"
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SetLogLevelCommand represents a command to change the level of a logger
+type SetLogLevelCommand struct {
+	// Logger is the logger name, the root logger when empty
+	Logger string
+	Level  string
+	// TTL makes the change temporary when positive
+	TTL time.Duration
+}
+
+// SetLogLevelCommandHandler handles set log level commands
+type SetLogLevelCommandHandler struct {
+	logger     logger.Logger
+	controller ports.LogLevelController
+}
+
+// NewSetLogLevelCommandHandler creates a new set log level command handler
+func NewSetLogLevelCommandHandler(logger logger.Logger, controller ports.LogLevelController) *SetLogLevelCommandHandler {
+	return &SetLogLevelCommandHandler{
+		logger:     logger,
+		controller: controller,
+	}
+}
+
+// Handle executes the set log level command
+func (h *SetLogLevelCommandHandler) Handle(ctx context.Context, command SetLogLevelCommand) (domain.LogLevel, error) {
+	log := h.logger.FromContext(ctx)
+	if command.Logger == "" {
+		command.Logger = domain.RootLogger
+	}
+	_, span := tracing.StartSpan(ctx, "SetLogLevelCommandHandler.Handle",
+		attribute.String("admin.target_logger", command.Logger), attribute.String("admin.log_level", command.Level))
+	defer span.End()
+
+	if command.TTL < 0 {
+		err := fmt.Errorf("%w: ttl must not be negative", domain.ErrInvalidLogLevel)
+		tracing.RecordError(span, err)
+		return domain.LogLevel{}, err
+	}
+
+	level, err := h.controller.SetLevel(command.Logger, command.Level, command.TTL)
+	if err != nil {
+		tracing.RecordError(span, err)
+		log.Warn().Err(err).Str("target_logger", command.Logger).Msg("Rejected log level change")
+		return domain.LogLevel{}, err
+	}
+
+	log.Warn().
+		Str("target_logger", level.Logger).
+		Str("log_level", level.Level).
+		Dur("ttl_ms", command.TTL).
+		Msg("Log level changed")
+	return level, nil
+}
+
+// ResetLogLevelCommand represents a command to restore the configured level of a logger
+type ResetLogLevelCommand struct {
+	// Logger is the logger name, the root logger when empty
+	Logger string
+}
+
+// ResetLogLevelCommandHandler handles reset log level commands
+type ResetLogLevelCommandHandler struct {
+	logger     logger.Logger
+	controller ports.LogLevelController
+}
+
+// NewResetLogLevelCommandHandler creates a new reset log level command handler
+func NewResetLogLevelCommandHandler(logger logger.Logger, controller ports.LogLevelController) *ResetLogLevelCommandHandler {
+	return &ResetLogLevelCommandHandler{
+		logger:     logger,
+		controller: controller,
+	}
+}
+
+// Handle executes the reset log level command
+func (h *ResetLogLevelCommandHandler) Handle(ctx context.Context, command ResetLogLevelCommand) (domain.LogLevel, error) {
+	if command.Logger == "" {
+		command.Logger = domain.RootLogger
+	}
+	_, span := tracing.StartSpan(ctx, "ResetLogLevelCommandHandler.Handle", attribute.String("admin.target_logger", command.Logger))
+	defer span.End()
+
+	level := h.controller.ResetLevel(command.Logger)
+	h.logger.FromContext(ctx).Warn().Str("target_logger", level.Logger).Str("log_level", level.Level).Msg("Log level reset")
+	return level, nil
+}
+
+// LogLevelService changes log levels of the running service
+type LogLevelService struct {
+	logger          logger.Logger
+	setLevelHandler *SetLogLevelCommandHandler
+	resetHandler    *ResetLogLevelCommandHandler
+}
+
+// NewLogLevelService creates a new log level service
+func NewLogLevelService(logger logger.Logger, setLevelHandler *SetLogLevelCommandHandler, resetHandler *ResetLogLevelCommandHandler) *LogLevelService {
+	return &LogLevelService{
+		logger:          logger,
+		setLevelHandler: setLevelHandler,
+		resetHandler:    resetHandler,
+	}
+}
+
+// SetLevel changes the level of a logger, a positive ttl reverts the change once it elapses
+func (s *LogLevelService) SetLevel(ctx context.Context, loggerName, level string, ttl time.Duration) (domain.LogLevel, error) {
+	return s.setLevelHandler.Handle(ctx, SetLogLevelCommand{Logger: loggerName, Level: level, TTL: ttl})
+}
+
+// ResetLevel restores the configured level of a logger
+func (s *LogLevelService) ResetLevel(ctx context.Context, loggerName string) (domain.LogLevel, error) {
+	return s.resetHandler.Handle(ctx, ResetLogLevelCommand{Logger: loggerName})
+}
@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+
+	"github.com/go-clean/internal/admin/domain"
+	"github.com/go-clean/internal/admin/ports"
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
+)
+
+// GetLogLevelsQuery represents a query to list the levels of all loggers
+type GetLogLevelsQuery struct{}
+
+// GetLogLevelsQueryHandler handles log levels queries
+type GetLogLevelsQueryHandler struct {
+	logger     logger.Logger
+	controller ports.LogLevelController
+}
+
+// NewGetLogLevelsQueryHandler creates a new log levels query handler
+func NewGetLogLevelsQueryHandler(logger logger.Logger, controller ports.LogLevelController) *GetLogLevelsQueryHandler {
+	return &GetLogLevelsQueryHandler{
+		logger:     logger,
+		controller: controller,
+	}
+}
+
+// Handle executes the log levels query
+func (h *GetLogLevelsQueryHandler) Handle(ctx context.Context, query GetLogLevelsQuery) (*domain.LogLevelsResponse, error) {
+	_, span := tracing.StartSpan(ctx, "GetLogLevelsQueryHandler.Handle")
+	defer span.End()
+
+	levels := h.controller.Levels()
+	h.logger.FromContext(ctx).Debug().Int("loggers", len(levels)).Msg("Log levels retrieved")
+	return domain.NewLogLevelsResponse(levels), nil
+}
+
+// LogLevelsService exposes the log levels of the running service
+type LogLevelsService struct {
+	logger       logger.Logger
+	queryHandler *GetLogLevelsQueryHandler
+}
+
+// NewLogLevelsService creates a new log levels service
+func NewLogLevelsService(logger logger.Logger, queryHandler *GetLogLevelsQueryHandler) *LogLevelsService {
+	return &LogLevelsService{
+		logger:       logger,
+		queryHandler: queryHandler,
+	}
+}
+
+// GetLogLevels returns the levels of the root logger and every named logger
+func (s *LogLevelsService) GetLogLevels(ctx context.Context) (*domain.LogLevelsResponse, error) {
+	s.logger.FromContext(ctx).Debug().Msg("Log levels requested")
+	return s.queryHandler.Handle(ctx, GetLogLevelsQuery{})
+}
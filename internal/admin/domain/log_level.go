@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// RootLogger is the name the root logger is addressed by
+const RootLogger = "root"
+
+// ErrInvalidLogLevel is returned when a log level change is rejected
+var ErrInvalidLogLevel = errors.New("invalid log level")
+
+// LogLevel describes the level of a single logger
+type LogLevel struct {
+	Logger          string     `json:"logger"`
+	Level           string     `json:"level"`
+	ConfiguredLevel string     `json:"configured_level,omitempty"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+}
+
+// LogLevelsResponse lists the levels of the root logger and every named logger
+type LogLevelsResponse struct {
+	Loggers   []LogLevel `json:"loggers"`
+	Timestamp time.Time  `json:"timestamp"`
+}
+
+// NewLogLevelsResponse creates a new log levels response
+func NewLogLevelsResponse(levels []LogLevel) *LogLevelsResponse {
+	return &LogLevelsResponse{
+		Loggers:   levels,
+		Timestamp: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,69 @@
+package infrastructure
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-clean/internal/admin/domain"
+	"github.com/go-clean/platform/logger"
+)
+
+// LogLevelControllerAdapter implements the LogLevelController port using the platform level controller
+type LogLevelControllerAdapter struct {
+	levels *logger.LevelController
+}
+
+// NewLogLevelControllerAdapter creates a new log level controller adapter
+func NewLogLevelControllerAdapter(levels *logger.LevelController) *LogLevelControllerAdapter {
+	return &LogLevelControllerAdapter{levels: levels}
+}
+
+// Levels returns the levels of the root logger and every named logger
+func (a *LogLevelControllerAdapter) Levels() []domain.LogLevel {
+	settings := a.levels.Settings()
+
+	levels := make([]domain.LogLevel, 0, len(settings))
+	for _, setting := range settings {
+		levels = append(levels, toLogLevel(setting))
+	}
+	return levels
+}
+
+// SetLevel changes the level of a logger, a positive ttl reverts the change once it elapses
+func (a *LogLevelControllerAdapter) SetLevel(name, level string, ttl time.Duration) (domain.LogLevel, error) {
+	setting, err := a.levels.SetLevel(loggerName(name), level, ttl)
+	if err != nil {
+		return domain.LogLevel{}, fmt.Errorf("%w: %v", domain.ErrInvalidLogLevel, err)
+	}
+	return toLogLevel(setting), nil
+}
+
+// ResetLevel restores the configured level of a logger
+func (a *LogLevelControllerAdapter) ResetLevel(name string) domain.LogLevel {
+	return toLogLevel(a.levels.ResetLevel(loggerName(name)))
+}
+
+// loggerName maps the root logger name used by the API to the platform one
+func loggerName(name string) string {
+	if name == domain.RootLogger {
+		return logger.RootLoggerName
+	}
+	return name
+}
+
+// toLogLevel converts a platform level setting into a domain log level
+func toLogLevel(setting logger.LevelSetting) domain.LogLevel {
+	level := domain.LogLevel{
+		Logger:          setting.Name,
+		Level:           setting.Level,
+		ConfiguredLevel: setting.ConfiguredLevel,
+	}
+	if level.Logger == logger.RootLoggerName {
+		level.Logger = domain.RootLogger
+	}
+	if setting.Temporary() {
+		expiresAt := setting.ExpiresAt.UTC()
+		level.ExpiresAt = &expiresAt
+	}
+	return level
+}
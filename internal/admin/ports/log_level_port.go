@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/go-clean/internal/admin/domain"
+)
+
+// LogLevelController defines the interface for inspecting and changing log levels at runtime
+type LogLevelController interface {
+	// Levels returns the levels of the root logger and every named logger
+	Levels() []domain.LogLevel
+
+	// SetLevel changes the level of a logger, a positive ttl reverts the change once it elapses
+	SetLevel(logger, level string, ttl time.Duration) (domain.LogLevel, error)
+
+	// ResetLevel restores the configured level of a logger
+	ResetLevel(logger string) domain.LogLevel
+}
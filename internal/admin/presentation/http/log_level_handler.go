@@ -0,0 +1,175 @@
+package http
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-clean/internal/admin/application/command"
+	"github.com/go-clean/internal/admin/application/query"
+	"github.com/go-clean/internal/admin/domain"
+	"github.com/go-clean/platform/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// LogLevelHandlerConfig holds configuration for the log level HTTP handler
+type LogLevelHandlerConfig struct {
+	// Enabled registers the admin routes
+	Enabled bool
+	// Token is the bearer token that authenticates admin callers, routes are not
+	// registered without one
+	Token string
+}
+
+// SetLogLevelRequest is the body of a log level change
+type SetLogLevelRequest struct {
+	Level string `json:"level"`
+	// TTL is a Go duration such as "10m" after which the change reverts, permanent when empty
+	TTL string `json:"ttl,omitempty"`
+}
+
+// LogLevelHandler handles HTTP requests for runtime log level control
+type LogLevelHandler struct {
+	logger           logger.Logger
+	config           LogLevelHandlerConfig
+	logLevelsService *query.LogLevelsService
+	logLevelService  *command.LogLevelService
+}
+
+// NewLogLevelHandler creates a new log level HTTP handler
+func NewLogLevelHandler(logger logger.Logger, config LogLevelHandlerConfig, logLevelsService *query.LogLevelsService, logLevelService *command.LogLevelService) *LogLevelHandler {
+	return &LogLevelHandler{
+		logger:           logger,
+		config:           config,
+		logLevelsService: logLevelsService,
+		logLevelService:  logLevelService,
+	}
+}
+
+// GetLogLevels handles GET /admin/log-levels requests
+// @Summary List log levels
+// @Description Returns the level of the root logger and of every named logger, including temporary overrides
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.LogLevelsResponse
+// @Failure 401 {object} map[string]string "Missing or invalid admin token"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /admin/log-levels [get]
+func (h *LogLevelHandler) GetLogLevels(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	response, err := h.logLevelsService.GetLogLevels(ctx)
+	if err != nil {
+		h.logger.FromContext(ctx).Error().Err(err).Msg("Failed to get log levels")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to get log levels",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// SetLogLevel handles PUT /admin/log-levels/:logger requests
+// @Summary Change a log level
+// @Description Changes the level of the root logger ("root") or of a named logger, optionally reverting after a ttl
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param logger path string true "Logger name, root for the root logger"
+// @Param request body SetLogLevelRequest true "New level and optional ttl"
+// @Success 200 {object} domain.LogLevel
+// @Failure 400 {object} map[string]string "Invalid level or ttl"
+// @Failure 401 {object} map[string]string "Missing or invalid admin token"
+// @Router /admin/log-levels/{logger} [put]
+func (h *LogLevelHandler) SetLogLevel(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var request SetLogLevelRequest
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	var ttl time.Duration
+	if request.TTL != "" {
+		parsed, err := time.ParseDuration(request.TTL)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid ttl, expected a duration such as 10m",
+			})
+		}
+		ttl = parsed
+	}
+
+	// Route params point into the reused request buffer, the name outlives the request
+	level, err := h.logLevelService.SetLevel(ctx, utils.CopyString(c.Params("logger")), request.Level, ttl)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidLogLevel) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		h.logger.FromContext(ctx).Error().Err(err).Msg("Failed to set log level")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to set log level",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(level)
+}
+
+// ResetLogLevel handles DELETE /admin/log-levels/:logger requests
+// @Summary Reset a log level
+// @Description Removes overrides of a named logger so it inherits its parent's level again, the root logger returns to its configured level
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param logger path string true "Logger name, root for the root logger"
+// @Success 200 {object} domain.LogLevel
+// @Failure 401 {object} map[string]string "Missing or invalid admin token"
+// @Router /admin/log-levels/{logger} [delete]
+func (h *LogLevelHandler) ResetLogLevel(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	level, err := h.logLevelService.ResetLevel(ctx, utils.CopyString(c.Params("logger")))
+	if err != nil {
+		h.logger.FromContext(ctx).Error().Err(err).Msg("Failed to reset log level")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to reset log level",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(level)
+}
+
+// authenticate rejects requests that do not carry the admin bearer token
+func (h *LogLevelHandler) authenticate(c *fiber.Ctx) error {
+	token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.config.Token)) != 1 {
+		h.logger.FromContext(c.UserContext()).Warn().Str("ip", c.IP()).Msg("Rejected unauthenticated admin request")
+		c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Missing or invalid admin token",
+		})
+	}
+	return c.Next()
+}
+
+// RegisterRoutes registers the admin log level routes
+func (h *LogLevelHandler) RegisterRoutes(router fiber.Router) {
+	if !h.config.Enabled {
+		h.logger.Info().Msg("Admin endpoints disabled, skipping route registration")
+		return
+	}
+	if h.config.Token == "" {
+		h.logger.Warn().Msg("Admin token not configured, skipping admin route registration")
+		return
+	}
+
+	h.logger.Info().Msg("Registering admin routes")
+	admin := router.Group("/admin", h.authenticate)
+	admin.Get("/log-levels", h.GetLogLevels)
+	admin.Put("/log-levels/:logger", h.SetLogLevel)
+	admin.Delete("/log-levels/:logger", h.ResetLogLevel)
+	h.logger.Debug().Str("route", "/admin/log-levels").Msg("Log level routes registered")
+}
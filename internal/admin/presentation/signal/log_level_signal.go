@@ -0,0 +1,91 @@
+package signal
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/go-clean/internal/admin/application/command"
+	"github.com/go-clean/internal/admin/domain"
+	"github.com/go-clean/platform/logger"
+)
+
+// LogLevelSignalConfig holds configuration for the log level signal handler
+type LogLevelSignalConfig struct {
+	// Level is the root level applied on SIGHUP, the handler is disabled when empty
+	Level string
+	// TTL is how long the raised level lasts before reverting
+	TTL time.Duration
+}
+
+// LogLevelSignalHandler temporarily raises the root log level when the process receives SIGHUP
+type LogLevelSignalHandler struct {
+	logger          logger.Logger
+	config          LogLevelSignalConfig
+	logLevelService *command.LogLevelService
+
+	started   atomic.Bool
+	startOnce sync.Once
+	stopOnce  sync.Once
+	signals   chan os.Signal
+	done      chan struct{}
+}
+
+// NewLogLevelSignalHandler creates a new log level signal handler
+func NewLogLevelSignalHandler(logger logger.Logger, config LogLevelSignalConfig, logLevelService *command.LogLevelService) *LogLevelSignalHandler {
+	return &LogLevelSignalHandler{
+		logger:          logger,
+		config:          config,
+		logLevelService: logLevelService,
+		signals:         make(chan os.Signal, 1),
+		done:            make(chan struct{}),
+	}
+}
+
+// Enabled reports whether SIGHUP changes the log level
+func (h *LogLevelSignalHandler) Enabled() bool {
+	return h.config.Level != "" && h.config.TTL > 0
+}
+
+// Start starts listening for SIGHUP
+func (h *LogLevelSignalHandler) Start() {
+	if !h.Enabled() {
+		h.logger.Debug().Msg("Log level signal handler disabled")
+		return
+	}
+
+	h.startOnce.Do(func() {
+		h.logger.Info().Str("log_level", h.config.Level).Dur("ttl_ms", h.config.TTL).Msg("Send SIGHUP to raise the log level temporarily")
+		h.started.Store(true)
+		signal.Notify(h.signals, syscall.SIGHUP)
+		go h.run()
+	})
+}
+
+// Stop stops listening for SIGHUP and waits for the handler to finish
+func (h *LogLevelSignalHandler) Stop() {
+	if !h.started.Load() {
+		return
+	}
+
+	h.stopOnce.Do(func() {
+		signal.Stop(h.signals)
+		close(h.signals)
+	})
+	<-h.done
+}
+
+// run applies the configured level every time SIGHUP is received, extending the ttl
+func (h *LogLevelSignalHandler) run() {
+	defer close(h.done)
+
+	for range h.signals {
+		if _, err := h.logLevelService.SetLevel(context.Background(), domain.RootLogger, h.config.Level, h.config.TTL); err != nil {
+			h.logger.Error().Err(err).Msg("Failed to raise log level on SIGHUP")
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package admin
+
+import (
+	adminCommand "github.com/go-clean/internal/admin/application/command"
+	adminQuery "github.com/go-clean/internal/admin/application/query"
+	adminInfra "github.com/go-clean/internal/admin/infrastructure"
+	"github.com/go-clean/internal/admin/ports"
+	adminHttp "github.com/go-clean/internal/admin/presentation/http"
+	adminSignal "github.com/go-clean/internal/admin/presentation/signal"
+	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/logger"
+	"github.com/google/wire"
+)
+
+// loggerName is the name of the logger used by the admin module
+const loggerName = "admin"
+
+// ProvideLogLevelController provides the log level controller implementation
+func ProvideLogLevelController(levels *logger.LevelController) ports.LogLevelController {
+	return adminInfra.NewLogLevelControllerAdapter(levels)
+}
+
+// ProvideGetLogLevelsQueryHandler provides a log levels query handler
+func ProvideGetLogLevelsQueryHandler(logger logger.Logger, controller ports.LogLevelController) *adminQuery.GetLogLevelsQueryHandler {
+	return adminQuery.NewGetLogLevelsQueryHandler(logger.Named(loggerName), controller)
+}
+
+// ProvideLogLevelsService provides a log levels service
+func ProvideLogLevelsService(logger logger.Logger, queryHandler *adminQuery.GetLogLevelsQueryHandler) *adminQuery.LogLevelsService {
+	return adminQuery.NewLogLevelsService(logger.Named(loggerName), queryHandler)
+}
+
+// ProvideSetLogLevelCommandHandler provides a set log level command handler
+func ProvideSetLogLevelCommandHandler(logger logger.Logger, controller ports.LogLevelController) *adminCommand.SetLogLevelCommandHandler {
+	return adminCommand.NewSetLogLevelCommandHandler(logger.Named(loggerName), controller)
+}
+
+// ProvideResetLogLevelCommandHandler provides a reset log level command handler
+func ProvideResetLogLevelCommandHandler(logger logger.Logger, controller ports.LogLevelController) *adminCommand.ResetLogLevelCommandHandler {
+	return adminCommand.NewResetLogLevelCommandHandler(logger.Named(loggerName), controller)
+}
+
+// ProvideLogLevelService provides a log level service
+func ProvideLogLevelService(logger logger.Logger, setLevelHandler *adminCommand.SetLogLevelCommandHandler, resetHandler *adminCommand.ResetLogLevelCommandHandler) *adminCommand.LogLevelService {
+	return adminCommand.NewLogLevelService(logger.Named(loggerName), setLevelHandler, resetHandler)
+}
+
+// ProvideLogLevelHandlerConfig provides the log level HTTP handler configuration
+func ProvideLogLevelHandlerConfig(cfg *config.Config) adminHttp.LogLevelHandlerConfig {
+	return adminHttp.LogLevelHandlerConfig{
+		Enabled: cfg.Admin.Enabled,
+		Token:   cfg.Admin.Token,
+	}
+}
+
+// ProvideLogLevelHandler provides a log level HTTP handler
+func ProvideLogLevelHandler(
+	logger logger.Logger,
+	handlerConfig adminHttp.LogLevelHandlerConfig,
+	logLevelsService *adminQuery.LogLevelsService,
+	logLevelService *adminCommand.LogLevelService,
+) *adminHttp.LogLevelHandler {
+	return adminHttp.NewLogLevelHandler(logger.Named(loggerName), handlerConfig, logLevelsService, logLevelService)
+}
+
+// ProvideLogLevelSignalConfig provides the log level signal handler configuration
+func ProvideLogLevelSignalConfig(cfg *config.Config) adminSignal.LogLevelSignalConfig {
+	return adminSignal.LogLevelSignalConfig{
+		Level: cfg.Logging.SignalLevel,
+		TTL:   cfg.Logging.SignalTTL,
+	}
+}
+
+// ProvideLogLevelSignalHandler provides a log level signal handler
+func ProvideLogLevelSignalHandler(logger logger.Logger, signalConfig adminSignal.LogLevelSignalConfig, logLevelService *adminCommand.LogLevelService) *adminSignal.LogLevelSignalHandler {
+	return adminSignal.NewLogLevelSignalHandler(logger.Named(loggerName), signalConfig, logLevelService)
+}
+
+// AdminSet is a wire provider set for all admin dependencies
+var AdminSet = wire.NewSet(
+	ProvideLogLevelController,
+	ProvideGetLogLevelsQueryHandler,
+	ProvideLogLevelsService,
+	ProvideSetLogLevelCommandHandler,
+	ProvideResetLogLevelCommandHandler,
+	ProvideLogLevelService,
+	ProvideLogLevelHandlerConfig,
+	ProvideLogLevelHandler,
+	ProvideLogLevelSignalConfig,
+	ProvideLogLevelSignalHandler,
+)
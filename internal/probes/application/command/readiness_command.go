@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/platform/logger"
+)
+
+// MarkReadyCommand represents a command to mark the service as ready for traffic
+type MarkReadyCommand struct{}
+
+// MarkReadyCommandHandler handles mark ready commands
+type MarkReadyCommandHandler struct {
+	logger    logger.Logger
+	lifecycle *domain.Lifecycle
+}
+
+// NewMarkReadyCommandHandler creates a new mark ready command handler
+func NewMarkReadyCommandHandler(logger logger.Logger, lifecycle *domain.Lifecycle) *MarkReadyCommandHandler {
+	return &MarkReadyCommandHandler{
+		logger:    logger,
+		lifecycle: lifecycle,
+	}
+}
+
+// Handle executes the mark ready command
+func (h *MarkReadyCommandHandler) Handle(ctx context.Context, command MarkReadyCommand) error {
+	log := h.logger.FromContext(ctx)
+	if err := h.lifecycle.TransitionTo(domain.ReadinessStateReady); err != nil {
+		log.Error().Err(err).Msg("Failed to mark service as ready")
+		return err
+	}
+
+	log.Info().Str("state", string(domain.ReadinessStateReady)).Msg("Service is ready to receive traffic")
+	return nil
+}
+
+// StartDrainingCommand represents a command to stop accepting new traffic before shutdown
+type StartDrainingCommand struct{}
+
+// StartDrainingCommandHandler handles start draining commands
+type StartDrainingCommandHandler struct {
+	logger    logger.Logger
+	lifecycle *domain.Lifecycle
+}
+
+// NewStartDrainingCommandHandler creates a new start draining command handler
+func NewStartDrainingCommandHandler(logger logger.Logger, lifecycle *domain.Lifecycle) *StartDrainingCommandHandler {
+	return &StartDrainingCommandHandler{
+		logger:    logger,
+		lifecycle: lifecycle,
+	}
+}
+
+// Handle executes the start draining command
+func (h *StartDrainingCommandHandler) Handle(ctx context.Context, command StartDrainingCommand) error {
+	log := h.logger.FromContext(ctx)
+	if err := h.lifecycle.TransitionTo(domain.ReadinessStateDraining); err != nil {
+		log.Error().Err(err).Msg("Failed to start draining")
+		return err
+	}
+
+	log.Info().Str("state", string(domain.ReadinessStateDraining)).Msg("Service is draining, readiness probe will fail")
+	return nil
+}
+
+// LifecycleService drives the readiness state machine of the service
+type LifecycleService struct {
+	logger               logger.Logger
+	markReadyHandler     *MarkReadyCommandHandler
+	startDrainingHandler *StartDrainingCommandHandler
+}
+
+// NewLifecycleService creates a new lifecycle service
+func NewLifecycleService(logger logger.Logger, markReadyHandler *MarkReadyCommandHandler, startDrainingHandler *StartDrainingCommandHandler) *LifecycleService {
+	return &LifecycleService{
+		logger:               logger,
+		markReadyHandler:     markReadyHandler,
+		startDrainingHandler: startDrainingHandler,
+	}
+}
+
+// MarkReady marks the service as ready to receive traffic
+func (s *LifecycleService) MarkReady(ctx context.Context) error {
+	s.logger.FromContext(ctx).Debug().Msg("Mark ready requested")
+	return s.markReadyHandler.Handle(ctx, MarkReadyCommand{})
+}
+
+// StartDraining makes the readiness probe fail so load balancers stop sending traffic
+func (s *LifecycleService) StartDraining(ctx context.Context) error {
+	s.logger.FromContext(ctx).Debug().Msg("Start draining requested")
+	return s.startDrainingHandler.Handle(ctx, StartDrainingCommand{})
+}
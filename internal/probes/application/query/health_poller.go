@@ -0,0 +1,178 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/internal/probes/ports"
+	"github.com/go-clean/platform/logger"
+)
+
+// healthPollerComponent is the name the poller heartbeat is registered under
+const healthPollerComponent = "health_poller"
+
+// HealthPollerConfig holds configuration for the background health poller
+type HealthPollerConfig struct {
+	// Enabled turns on background refreshing and serving of cached results
+	Enabled bool
+	// Interval is how often the health checks are refreshed in the background
+	Interval time.Duration
+	// MaxAge is the maximum age of a cached result before a live check is forced
+	MaxAge time.Duration
+	// HeartbeatTimeout is how long a refresh may take before the liveness probe reports the poller as wedged
+	HeartbeatTimeout time.Duration
+}
+
+// HealthPoller refreshes health check results in the background and caches the
+// latest response, protecting dependencies from probe storms
+type HealthPoller struct {
+	logger       logger.Logger
+	queryHandler *GetHealthQueryHandler
+	config       HealthPollerConfig
+	watchdog     ports.Watchdog
+
+	mu     sync.RWMutex
+	latest *domain.HealthResponse
+	// stale reports whether the last read found the cached result too old, so the
+	// transitions are logged once instead of on every probe
+	stale atomic.Bool
+
+	started   atomic.Bool
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewHealthPoller creates a new health poller
+func NewHealthPoller(logger logger.Logger, queryHandler *GetHealthQueryHandler, config HealthPollerConfig, watchdog ports.Watchdog) *HealthPoller {
+	return &HealthPoller{
+		logger:       logger,
+		queryHandler: queryHandler,
+		config:       config,
+		watchdog:     watchdog,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// Enabled reports whether cached results are served
+func (p *HealthPoller) Enabled() bool {
+	return p.config.Enabled && p.config.Interval > 0
+}
+
+// Start starts refreshing health check results in the background
+func (p *HealthPoller) Start() {
+	if !p.Enabled() {
+		p.logger.Debug().Msg("Background health refresh disabled")
+		return
+	}
+
+	p.startOnce.Do(func() {
+		p.logger.Info().Dur("interval_ms", p.config.Interval).Msg("Starting background health refresh")
+		p.started.Store(true)
+		go p.run()
+	})
+}
+
+// Stop stops the background refresh and waits for it to finish
+func (p *HealthPoller) Stop() {
+	if !p.started.Load() {
+		return
+	}
+
+	p.stopOnce.Do(func() {
+		p.logger.Info().Msg("Stopping background health refresh")
+		close(p.stop)
+	})
+	<-p.done
+}
+
+// Latest returns a copy of the cached health response if it is not older than the configured max age
+func (p *HealthPoller) Latest() (*domain.HealthResponse, bool) {
+	if !p.Enabled() {
+		return nil, false
+	}
+
+	p.mu.RLock()
+	latest := p.latest
+	p.mu.RUnlock()
+
+	if latest == nil {
+		return nil, false
+	}
+
+	now := time.Now().UTC()
+	if p.config.MaxAge > 0 && now.Sub(latest.Timestamp) > p.config.MaxAge {
+		if p.stale.CompareAndSwap(false, true) {
+			p.logger.Warn().Time("checked_at", latest.Timestamp).Dur("max_age_ms", p.config.MaxAge).Msg("Cached health result is stale, running live checks")
+		}
+		return nil, false
+	}
+	if p.stale.CompareAndSwap(true, false) {
+		p.logger.Info().Time("checked_at", latest.Timestamp).Msg("Cached health result is fresh again")
+	}
+
+	return latest.CachedCopy(now), true
+}
+
+// Store caches a health response when background refreshing is enabled
+func (p *HealthPoller) Store(response *domain.HealthResponse) {
+	if !p.Enabled() || response == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.latest == nil || !response.Timestamp.Before(p.latest.Timestamp) {
+		p.latest = response
+	}
+}
+
+// run refreshes the health check results until the poller is stopped
+func (p *HealthPoller) run() {
+	defer close(p.done)
+
+	heartbeat, err := p.watchdog.Register(healthPollerComponent, p.config.HeartbeatTimeout)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("Failed to register health poller heartbeat")
+	} else {
+		defer heartbeat.Stop()
+	}
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	p.refresh()
+	beat(heartbeat)
+	for {
+		select {
+		case <-ticker.C:
+			p.refresh()
+			beat(heartbeat)
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// refresh runs the health checks and caches the result
+func (p *HealthPoller) refresh() {
+	p.logger.Debug().Msg("Refreshing cached health status")
+	response, err := p.queryHandler.Handle(context.Background(), GetHealthQuery{})
+	if err != nil {
+		p.logger.Error().Err(err).Msg("Background health refresh failed")
+		return
+	}
+	p.Store(response)
+}
+
+// beat signals progress on a heartbeat that may have failed to register
+func beat(heartbeat ports.Heartbeat) {
+	if heartbeat != nil {
+		heartbeat.Beat()
+	}
+}
@@ -0,0 +1,214 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/internal/probes/ports"
+	"github.com/go-clean/platform/logger/logtest"
+)
+
+// fakeWatchdog hands out heartbeats counting their beats, or fails registration when err is set
+type fakeWatchdog struct {
+	err       error
+	heartbeat fakeHeartbeat
+}
+
+func (w *fakeWatchdog) Register(string, time.Duration) (ports.Heartbeat, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return &w.heartbeat, nil
+}
+
+func (w *fakeWatchdog) Stalled(time.Time) []domain.LivenessFailure { return nil }
+
+type fakeHeartbeat struct {
+	beats   atomic.Int32
+	stopped atomic.Bool
+}
+
+func (h *fakeHeartbeat) Beat() { h.beats.Add(1) }
+func (h *fakeHeartbeat) Stop() { h.stopped.Store(true) }
+
+// responseAt returns a healthy response checked at the given time
+func responseAt(at time.Time) *domain.HealthResponse {
+	return &domain.HealthResponse{Status: domain.HealthStatusHealthy, Checks: map[string]domain.Check{}, Timestamp: at}
+}
+
+func TestHealthPollerLatest(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name   string
+		config HealthPollerConfig
+		// stored are the timestamps of the responses stored in order
+		stored  []time.Time
+		wantOK  bool
+		wantAt  time.Time
+		wantLog string
+	}{
+		{name: "disabled", config: HealthPollerConfig{MaxAge: time.Minute}, stored: []time.Time{now}},
+		{name: "enabled without interval", config: HealthPollerConfig{Enabled: true, MaxAge: time.Minute}, stored: []time.Time{now}},
+		{name: "nothing cached yet", config: HealthPollerConfig{Enabled: true, Interval: time.Second, MaxAge: time.Minute}},
+		{
+			name:   "fresh result",
+			config: HealthPollerConfig{Enabled: true, Interval: time.Second, MaxAge: time.Minute},
+			stored: []time.Time{now.Add(-time.Second)},
+			wantOK: true,
+			wantAt: now.Add(-time.Second),
+		},
+		{
+			name:    "stale result",
+			config:  HealthPollerConfig{Enabled: true, Interval: time.Second, MaxAge: time.Minute},
+			stored:  []time.Time{now.Add(-time.Hour)},
+			wantLog: "Cached health result is stale, running live checks",
+		},
+		{
+			name:   "no max age",
+			config: HealthPollerConfig{Enabled: true, Interval: time.Second},
+			stored: []time.Time{now.Add(-time.Hour)},
+			wantOK: true,
+			wantAt: now.Add(-time.Hour),
+		},
+		{
+			name:   "older result does not replace a newer one",
+			config: HealthPollerConfig{Enabled: true, Interval: time.Second, MaxAge: time.Minute},
+			stored: []time.Time{now.Add(-time.Second), now.Add(-2 * time.Second)},
+			wantOK: true,
+			wantAt: now.Add(-time.Second),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logtest.New()
+			poller := NewHealthPoller(log, nil, tt.config, nil)
+			for _, at := range tt.stored {
+				poller.Store(responseAt(at))
+			}
+
+			latest, ok := poller.Latest()
+			if ok != tt.wantOK {
+				t.Fatalf("Latest() ok = %t, want %t", ok, tt.wantOK)
+			}
+			if ok {
+				if !latest.Timestamp.Equal(tt.wantAt) {
+					t.Errorf("timestamp = %s, want %s", latest.Timestamp, tt.wantAt)
+				}
+				if !latest.Cached || latest.AgeMs <= 0 {
+					t.Errorf("cached = %t, age = %dms, want a cached copy with its age", latest.Cached, latest.AgeMs)
+				}
+			}
+			if tt.wantLog != "" {
+				log.AssertLogged(t, logtest.Level(logtest.LevelWarn), logtest.Message(tt.wantLog))
+			}
+		})
+	}
+}
+
+func TestHealthPollerLogsStalenessTransitionsOnce(t *testing.T) {
+	log := logtest.New()
+	poller := NewHealthPoller(log, nil, HealthPollerConfig{Enabled: true, Interval: time.Second, MaxAge: time.Minute}, nil)
+	poller.Store(responseAt(time.Now().UTC().Add(-time.Hour)))
+
+	for range 3 {
+		if _, ok := poller.Latest(); ok {
+			t.Fatal("Latest() served a stale result")
+		}
+	}
+	if got := len(log.Find(logtest.Level(logtest.LevelWarn))); got != 1 {
+		t.Errorf("logged %d staleness warnings, want 1", got)
+	}
+
+	poller.Store(responseAt(time.Now().UTC()))
+	for range 3 {
+		if _, ok := poller.Latest(); !ok {
+			t.Fatal("Latest() did not serve the fresh result")
+		}
+	}
+	if got := len(log.Find(logtest.Message("Cached health result is fresh again"))); got != 1 {
+		t.Errorf("logged %d recoveries, want 1", got)
+	}
+}
+
+func TestHealthPollerRefreshesInBackground(t *testing.T) {
+	tests := []struct {
+		name          string
+		watchdog      *fakeWatchdog
+		wantHeartbeat bool
+	}{
+		{name: "with heartbeat", watchdog: &fakeWatchdog{}, wantHeartbeat: true},
+		{name: "heartbeat registration failed", watchdog: &fakeWatchdog{err: errors.New("duplicate")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logtest.New()
+			checker := &fakeChecker{name: "database", critical: true}
+			handler := newTestHandler(t, logtest.Nop(), time.Second, checker)
+			poller := NewHealthPoller(log, handler, HealthPollerConfig{Enabled: true, Interval: 5 * time.Millisecond, MaxAge: time.Minute}, tt.watchdog)
+
+			poller.Start()
+			deadline := time.Now().Add(time.Second)
+			for checker.calls.Load() < 3 && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+			poller.Stop()
+			// Stop is idempotent
+			poller.Stop()
+
+			if checker.calls.Load() < 3 {
+				t.Fatalf("checks ran %d times, want the poller to keep refreshing", checker.calls.Load())
+			}
+			if _, ok := poller.Latest(); !ok {
+				t.Error("Latest() did not serve the refreshed result")
+			}
+
+			heartbeat := &tt.watchdog.heartbeat
+			if tt.wantHeartbeat {
+				if heartbeat.beats.Load() == 0 || !heartbeat.stopped.Load() {
+					t.Errorf("beats = %d, stopped = %t, want beats and the heartbeat stopped", heartbeat.beats.Load(), heartbeat.stopped.Load())
+				}
+				return
+			}
+			log.AssertLogged(t, logtest.Level(logtest.LevelError), logtest.Message("Failed to register health poller heartbeat"))
+		})
+	}
+}
+
+func TestHealthServiceServesCachedResults(t *testing.T) {
+	checker := &fakeChecker{name: "database", critical: true}
+	handler := newTestHandler(t, logtest.Nop(), time.Second, checker)
+	poller := NewHealthPoller(logtest.Nop(), handler, HealthPollerConfig{Enabled: true, Interval: time.Hour, MaxAge: time.Minute}, nil)
+	service := NewHealthService(logtest.Nop(), handler, poller)
+	ctx := context.Background()
+
+	steps := []struct {
+		name       string
+		get        func(ctx context.Context) (*domain.HealthResponse, error)
+		wantCached bool
+		wantCalls  int32
+	}{
+		{name: "nothing cached runs the checks", get: service.GetHealthStatus, wantCalls: 1},
+		{name: "cached result", get: service.GetHealthStatus, wantCached: true, wantCalls: 1},
+		{name: "fresh request runs the checks", get: service.GetFreshHealthStatus, wantCalls: 2},
+		{name: "fresh result is cached", get: service.GetHealthStatus, wantCached: true, wantCalls: 2},
+	}
+	for _, step := range steps {
+		response, err := step.get(ctx)
+		if err != nil {
+			t.Fatalf("%s: failed: %v", step.name, err)
+		}
+		if response.Cached != step.wantCached {
+			t.Errorf("%s: cached = %t, want %t", step.name, response.Cached, step.wantCached)
+		}
+		if got := checker.calls.Load(); got != step.wantCalls {
+			t.Errorf("%s: checks ran %d times, want %d", step.name, got, step.wantCalls)
+		}
+	}
+}
@@ -2,97 +2,249 @@ package query
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/go-clean/internal/probes/domain"
 	"github.com/go-clean/internal/probes/ports"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // GetHealthQuery represents a query to get system health status
-type GetHealthQuery struct{}
+type GetHealthQuery struct {
+	// Verbose collects the dependency details of checkers implementing
+	// ports.HealthDetailsProvider, which cost extra calls and are only shown to
+	// verbose callers
+	Verbose bool
+}
+
+// HealthQueryConfig holds configuration for the health query handler
+type HealthQueryConfig struct {
+	// Timeout is the overall deadline for running all health checks
+	Timeout time.Duration
+}
 
 // GetHealthQueryHandler handles health check queries
 type GetHealthQueryHandler struct {
-	logger          logger.Logger
-	databaseChecker ports.DatabaseChecker
-	redisChecker    ports.RedisChecker
+	logger   logger.Logger
+	registry *HealthCheckerRegistry
+	config   HealthQueryConfig
+	metrics  ports.HealthMetricsRecorder
+
+	historyMu sync.Mutex
+	histories map[string]*domain.CheckHistory
+}
+
+// checkResult holds the outcome of a single health check run
+type checkResult struct {
+	name         string
+	critical     bool
+	err          error
+	responseTime time.Duration
+	details      map[string]any
 }
 
 // NewGetHealthQueryHandler creates a new health query handler
-func NewGetHealthQueryHandler(logger logger.Logger, databaseChecker ports.DatabaseChecker, redisChecker ports.RedisChecker) *GetHealthQueryHandler {
+func NewGetHealthQueryHandler(logger logger.Logger, registry *HealthCheckerRegistry, config HealthQueryConfig, metrics ports.HealthMetricsRecorder) *GetHealthQueryHandler {
 	return &GetHealthQueryHandler{
-		logger:          logger,
-		databaseChecker: databaseChecker,
-		redisChecker:    redisChecker,
+		logger:    logger,
+		registry:  registry,
+		config:    config,
+		metrics:   metrics,
+		histories: make(map[string]*domain.CheckHistory),
 	}
 }
 
-// Handle executes the health check query
+// Handle executes the health check query, running all registered checks concurrently
 func (h *GetHealthQueryHandler) Handle(ctx context.Context, query GetHealthQuery) (*domain.HealthResponse, error) {
-	h.logger.Info().Msg("Starting health check")
+	log := h.logger.FromContext(ctx)
+	ctx, span := tracing.StartSpan(ctx, "GetHealthQueryHandler.Handle")
+	defer span.End()
+
+	log.Info().Msg("Starting health check")
 	response := domain.NewHealthResponse()
 
-	// Check database connectivity
-	if h.databaseChecker != nil {
-		h.logger.Debug().Msg("Checking database connectivity")
-		dbHealthy, dbResponseTime, err := h.databaseChecker.CheckDatabase(ctx)
-		if err != nil {
-			h.logger.Error().Err(err).Msg("Database health check failed")
-			response.AddCheck("database", domain.CheckStatusDown, 0)
-		} else {
-			status := domain.CheckStatusUp
-			if !dbHealthy {
-				status = domain.CheckStatusDown
-				h.logger.Warn().Msg("Database is not healthy")
+	// Bound the whole health check by the overall deadline
+	if h.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.config.Timeout)
+		defer cancel()
+	}
+
+	// Fan out every registered dependency check
+	start := time.Now()
+	checkers := h.registry.Checkers()
+	pending := make(map[string]ports.HealthChecker, len(checkers))
+	results := make(chan checkResult, len(checkers))
+	for _, checker := range checkers {
+		pending[checker.Name()] = checker
+		go func() {
+			results <- h.runCheck(ctx, checker, query.Verbose)
+		}()
+	}
+
+	// Collect results until all checks finished or the overall deadline expired
+collect:
+	for len(pending) > 0 {
+		select {
+		case result := <-results:
+			delete(pending, result.name)
+			if result.err != nil {
+				h.logFailure(log, result.name, result.critical, result.err).Msg("Health check failed")
 			} else {
-				h.logger.Info().Int64("response_time_ms", dbResponseTime.Milliseconds()).Msg("Database health check passed")
+				log.Info().Str("checker", result.name).Dur("response_time_ms", result.responseTime).Msg("Health check passed")
 			}
-			response.AddCheck("database", status, dbResponseTime.Milliseconds())
+			response.AddCheck(result.name, h.buildCheck(result))
+		case <-ctx.Done():
+			break collect
 		}
 	}
 
-	// Check Redis connectivity
-	if h.redisChecker != nil {
-		h.logger.Debug().Msg("Checking Redis connectivity")
-		redisHealthy, redisResponseTime, err := h.redisChecker.CheckRedis(ctx)
-		if err != nil {
-			h.logger.Error().Err(err).Msg("Redis health check failed")
-			response.AddCheck("redis", domain.CheckStatusDown, 0)
-		} else {
-			status := domain.CheckStatusUp
-			if !redisHealthy {
-				status = domain.CheckStatusDown
-				h.logger.Warn().Msg("Redis is not healthy")
-			} else {
-				h.logger.Info().Int64("response_time_ms", redisResponseTime.Milliseconds()).Msg("Redis health check passed")
-			}
-			response.AddCheck("redis", status, redisResponseTime.Milliseconds())
+	// Checks that did not report back in time are considered down
+	for name, checker := range pending {
+		result := checkResult{
+			name:         name,
+			critical:     checker.Critical(),
+			err:          fmt.Errorf("health check did not complete before the deadline: %w", ctx.Err()),
+			responseTime: time.Since(start),
 		}
+		h.logFailure(log, result.name, result.critical, result.err).Msg("Health check did not complete before the deadline")
+		response.AddCheck(name, h.buildCheck(result))
 	}
 
 	// Determine overall status
 	response.DetermineOverallStatus()
-	h.logger.Info().Bool("is_healthy", response.IsHealthy()).Msg("Health check completed")
+	log.Info().Str("status", string(response.Status)).Bool("is_healthy", response.IsHealthy()).Msg("Health check completed")
+	span.SetAttributes(attribute.String("health.status", string(response.Status)))
+
+	// Export the results so dependency health can be alerted on from metrics
+	h.metrics.RecordHealth(response)
 
 	return response, nil
 }
 
+// runCheck runs a single health check bounded by its own timeout, collecting the
+// checker details when verbose
+func (h *GetHealthQueryHandler) runCheck(ctx context.Context, checker ports.HealthChecker, verbose bool) (result checkResult) {
+	result.name = checker.Name()
+	result.critical = checker.Critical()
+	h.logger.FromContext(ctx).Debug().Str("checker", result.name).Msg("Running health check")
+
+	if timeout := checker.Timeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "HealthChecker.Check",
+		attribute.String("health.check", result.name),
+		attribute.Bool("health.critical", result.critical),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		result.responseTime = time.Since(start)
+		if r := recover(); r != nil {
+			result.err = fmt.Errorf("health check panicked: %v", r)
+		}
+		tracing.RecordError(span, result.err)
+	}()
+
+	result.err = checker.Check(ctx)
+	if provider, ok := checker.(ports.HealthDetailsProvider); ok && verbose {
+		result.details = provider.Details(ctx)
+	}
+	return result
+}
+
+// buildCheck converts a check result into a domain check, updating the check history
+func (h *GetHealthQueryHandler) buildCheck(result checkResult) domain.Check {
+	check := domain.Check{
+		Status:         domain.CheckStatusUp,
+		Critical:       result.critical,
+		ResponseTimeMs: result.responseTime.Milliseconds(),
+		Details:        result.details,
+	}
+	if result.err != nil {
+		check.Status = domain.CheckStatusDown
+		check.Error = result.err.Error()
+	}
+
+	h.historyMu.Lock()
+	defer h.historyMu.Unlock()
+
+	history, ok := h.histories[result.name]
+	if !ok {
+		history = &domain.CheckHistory{}
+		h.histories[result.name] = history
+	}
+	history.Record(result.err == nil, time.Now().UTC())
+	check.LastSuccess = history.LastSuccess
+	check.ConsecutiveFailures = history.ConsecutiveFailures
+
+	return check
+}
+
+// logFailure starts a log event for a failed check, using a lower level for non-critical checks
+func (h *GetHealthQueryHandler) logFailure(log logger.Logger, name string, critical bool, err error) logger.LogEvent {
+	event := log.Warn()
+	if critical {
+		event = log.Error()
+	}
+	return event.Err(err).Str("checker", name).Bool("critical", critical)
+}
+
 // HealthService implements the HealthService port
 type HealthService struct {
 	logger       logger.Logger
 	queryHandler *GetHealthQueryHandler
+	poller       *HealthPoller
 }
 
 // NewHealthService creates a new health service
-func NewHealthService(logger logger.Logger, queryHandler *GetHealthQueryHandler) *HealthService {
+func NewHealthService(logger logger.Logger, queryHandler *GetHealthQueryHandler, poller *HealthPoller) *HealthService {
 	return &HealthService{
 		logger:       logger,
 		queryHandler: queryHandler,
+		poller:       poller,
 	}
 }
 
-// GetHealthStatus returns the current health status
+// GetHealthStatus returns the current health status, served from the background
+// refresh cache when it is enabled and fresh enough
 func (s *HealthService) GetHealthStatus(ctx context.Context) (*domain.HealthResponse, error) {
-	s.logger.Debug().Msg("Health status requested")
-	return s.queryHandler.Handle(ctx, GetHealthQuery{})
+	log := s.logger.FromContext(ctx)
+	log.Debug().Msg("Health status requested")
+	if cached, ok := s.poller.Latest(); ok {
+		log.Debug().Int64("age_ms", cached.AgeMs).Msg("Serving cached health status")
+		return cached, nil
+	}
+	return s.GetFreshHealthStatus(ctx)
+}
+
+// GetFreshHealthStatus runs all health checks live and refreshes the cache with the result
+func (s *HealthService) GetFreshHealthStatus(ctx context.Context) (*domain.HealthResponse, error) {
+	s.logger.FromContext(ctx).Debug().Msg("Fresh health status requested")
+	return s.run(ctx, GetHealthQuery{})
+}
+
+// GetVerboseHealthStatus runs all health checks live collecting the dependency
+// details, and refreshes the cache with the result
+func (s *HealthService) GetVerboseHealthStatus(ctx context.Context) (*domain.HealthResponse, error) {
+	s.logger.FromContext(ctx).Debug().Msg("Verbose health status requested")
+	return s.run(ctx, GetHealthQuery{Verbose: true})
+}
+
+// run executes a health query and caches its result
+func (s *HealthService) run(ctx context.Context, query GetHealthQuery) (*domain.HealthResponse, error) {
+	response, err := s.queryHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	s.poller.Store(response)
+	return response, nil
 }
@@ -0,0 +1,181 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/logger/logtest"
+)
+
+// fakeChecker is a health checker whose outcome is set by the test
+type fakeChecker struct {
+	name     string
+	critical bool
+	timeout  time.Duration
+	// delay is how long Check takes unless its context is done first
+	delay time.Duration
+	// block makes Check ignore its context until the channel is closed
+	block chan struct{}
+	err   error
+	panic bool
+	calls atomic.Int32
+}
+
+func (c *fakeChecker) Name() string           { return c.name }
+func (c *fakeChecker) Critical() bool         { return c.critical }
+func (c *fakeChecker) Timeout() time.Duration { return c.timeout }
+
+func (c *fakeChecker) Check(ctx context.Context) error {
+	c.calls.Add(1)
+	if c.panic {
+		panic("boom")
+	}
+	if c.block != nil {
+		<-c.block
+		return nil
+	}
+	if c.delay > 0 {
+		select {
+		case <-time.After(c.delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return c.err
+}
+
+// nopMetrics discards the recorded health results
+type nopMetrics struct{}
+
+func (nopMetrics) RecordHealth(*domain.HealthResponse) {}
+
+// newTestHandler creates a query handler running the given checkers
+func newTestHandler(t *testing.T, log logger.Logger, timeout time.Duration, checkers ...*fakeChecker) *GetHealthQueryHandler {
+	t.Helper()
+	registry := NewHealthCheckerRegistry(log)
+	for _, checker := range checkers {
+		if err := registry.Register(checker); err != nil {
+			t.Fatalf("Register failed: %v", err)
+		}
+	}
+	return NewGetHealthQueryHandler(log, registry, HealthQueryConfig{Timeout: timeout}, nopMetrics{})
+}
+
+func TestGetHealthQueryHandlerFanOut(t *testing.T) {
+	errDown := errors.New("connection refused")
+
+	tests := []struct {
+		name       string
+		checkers   func(block chan struct{}) []*fakeChecker
+		wantStatus domain.HealthStatus
+		// wantErrors maps the checks expected down to a part of their error
+		wantErrors map[string]string
+	}{
+		{
+			name: "all checks pass",
+			checkers: func(chan struct{}) []*fakeChecker {
+				return []*fakeChecker{{name: "database", critical: true}, {name: "redis"}}
+			},
+			wantStatus: domain.HealthStatusHealthy,
+		},
+		{
+			name: "critical check fails",
+			checkers: func(chan struct{}) []*fakeChecker {
+				return []*fakeChecker{{name: "database", critical: true, err: errDown}, {name: "redis"}}
+			},
+			wantStatus: domain.HealthStatusUnhealthy,
+			wantErrors: map[string]string{"database": "connection refused"},
+		},
+		{
+			name: "check exceeds its own timeout",
+			checkers: func(chan struct{}) []*fakeChecker {
+				return []*fakeChecker{
+					{name: "database", critical: true},
+					{name: "redis", timeout: 10 * time.Millisecond, delay: time.Second},
+				}
+			},
+			wantStatus: domain.HealthStatusDegraded,
+			wantErrors: map[string]string{"redis": "deadline exceeded"},
+		},
+		{
+			name: "check ignoring its context exceeds the overall deadline",
+			checkers: func(block chan struct{}) []*fakeChecker {
+				return []*fakeChecker{{name: "database", critical: true, block: block}, {name: "redis"}}
+			},
+			wantStatus: domain.HealthStatusUnhealthy,
+			wantErrors: map[string]string{"database": "did not complete before the deadline"},
+		},
+		{
+			name: "panicking check",
+			checkers: func(chan struct{}) []*fakeChecker {
+				return []*fakeChecker{{name: "database", critical: true}, {name: "redis", panic: true}}
+			},
+			wantStatus: domain.HealthStatusDegraded,
+			wantErrors: map[string]string{"redis": "panicked"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := make(chan struct{})
+			t.Cleanup(func() { close(block) })
+			checkers := tt.checkers(block)
+			handler := newTestHandler(t, logtest.Nop(), 50*time.Millisecond, checkers...)
+
+			start := time.Now()
+			response, err := handler.Handle(context.Background(), GetHealthQuery{})
+			if err != nil {
+				t.Fatalf("Handle failed: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("Handle took %s, want it bounded by the overall deadline", elapsed)
+			}
+
+			if response.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", response.Status, tt.wantStatus)
+			}
+			if len(response.Checks) != len(checkers) {
+				t.Fatalf("reported %d checks, want %d", len(response.Checks), len(checkers))
+			}
+			for _, checker := range checkers {
+				check := response.Checks[checker.name]
+				if check.Critical != checker.critical {
+					t.Errorf("%s critical = %t, want %t", checker.name, check.Critical, checker.critical)
+				}
+				want, down := tt.wantErrors[checker.name]
+				if check.IsUp() == down {
+					t.Errorf("%s status = %s, want down %t", checker.name, check.Status, down)
+				}
+				if !strings.Contains(check.Error, want) {
+					t.Errorf("%s error = %q, want it to contain %q", checker.name, check.Error, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetHealthQueryHandlerLogsMissedDeadline(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	log := logtest.New()
+	handler := newTestHandler(t, log, 10*time.Millisecond,
+		&fakeChecker{name: "database", critical: true, block: block},
+		&fakeChecker{name: "redis", block: block},
+	)
+
+	if _, err := handler.Handle(context.Background(), GetHealthQuery{}); err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	// Critical checks are logged as errors, non-critical ones as warnings
+	log.AssertLogged(t, logtest.Level(logtest.LevelError), logtest.Message("Health check did not complete before the deadline"),
+		logtest.Field("checker", "database"), logtest.Err(context.DeadlineExceeded))
+	log.AssertLogged(t, logtest.Level(logtest.LevelWarn), logtest.Message("Health check did not complete before the deadline"),
+		logtest.Field("checker", "redis"))
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-clean/internal/probes/ports"
+	"github.com/go-clean/platform/logger"
+)
+
+// HealthCheckerRegistry holds every health checker contributed to the probes module
+type HealthCheckerRegistry struct {
+	logger   logger.Logger
+	mu       sync.RWMutex
+	checkers []ports.HealthChecker
+	names    map[string]struct{}
+}
+
+// NewHealthCheckerRegistry creates a new health checker registry
+func NewHealthCheckerRegistry(logger logger.Logger) *HealthCheckerRegistry {
+	return &HealthCheckerRegistry{
+		logger: logger,
+		names:  make(map[string]struct{}),
+	}
+}
+
+// Register adds health checkers to the registry, rejecting duplicate or empty names
+func (r *HealthCheckerRegistry) Register(checkers ...ports.HealthChecker) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, checker := range checkers {
+		if checker == nil {
+			continue
+		}
+
+		name := checker.Name()
+		if name == "" {
+			return fmt.Errorf("health checker name must not be empty")
+		}
+		if _, exists := r.names[name]; exists {
+			return fmt.Errorf("health checker %q is already registered", name)
+		}
+
+		r.names[name] = struct{}{}
+		r.checkers = append(r.checkers, checker)
+		r.logger.Debug().Str("checker", name).Bool("critical", checker.Critical()).Msg("Health checker registered")
+	}
+
+	return nil
+}
+
+// Checkers returns a snapshot of the registered health checkers in registration order
+func (r *HealthCheckerRegistry) Checkers() []ports.HealthChecker {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	checkers := make([]ports.HealthChecker, len(r.checkers))
+	copy(checkers, r.checkers)
+	return checkers
+}
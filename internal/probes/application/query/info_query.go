@@ -0,0 +1,74 @@
+package query
+
+import (
+	"context"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/internal/probes/ports"
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
+)
+
+// InfoQueryConfig holds the application identity reported by the info query
+type InfoQueryConfig struct {
+	Name        string
+	Version     string
+	Environment string
+}
+
+// GetInfoQuery represents a query to get build and runtime information
+type GetInfoQuery struct{}
+
+// GetInfoQueryHandler handles build and runtime info queries
+type GetInfoQueryHandler struct {
+	logger            logger.Logger
+	config            InfoQueryConfig
+	buildInfoProvider ports.BuildInfoProvider
+}
+
+// NewGetInfoQueryHandler creates a new info query handler
+func NewGetInfoQueryHandler(logger logger.Logger, config InfoQueryConfig, buildInfoProvider ports.BuildInfoProvider) *GetInfoQueryHandler {
+	return &GetInfoQueryHandler{
+		logger:            logger,
+		config:            config,
+		buildInfoProvider: buildInfoProvider,
+	}
+}
+
+// Handle executes the info query
+func (h *GetInfoQueryHandler) Handle(ctx context.Context, query GetInfoQuery) (*domain.InfoResponse, error) {
+	log := h.logger.FromContext(ctx)
+	_, span := tracing.StartSpan(ctx, "GetInfoQueryHandler.Handle")
+	defer span.End()
+
+	log.Debug().Msg("Processing info query")
+
+	response := domain.NewInfoResponse(h.config.Name, h.config.Version, h.config.Environment, h.buildInfoProvider.BuildInfo())
+
+	log.Debug().
+		Str("version", response.Version).
+		Str("revision", response.Build.Revision).
+		Msg("Info query completed")
+
+	return response, nil
+}
+
+// InfoService implements the build and runtime info service
+type InfoService struct {
+	logger       logger.Logger
+	queryHandler *GetInfoQueryHandler
+}
+
+// NewInfoService creates a new info service
+func NewInfoService(logger logger.Logger, queryHandler *GetInfoQueryHandler) *InfoService {
+	return &InfoService{
+		logger:       logger,
+		queryHandler: queryHandler,
+	}
+}
+
+// GetInfo returns the build and runtime information of the service
+func (s *InfoService) GetInfo(ctx context.Context) (*domain.InfoResponse, error) {
+	s.logger.FromContext(ctx).Debug().Msg("Info requested")
+	return s.queryHandler.Handle(ctx, GetInfoQuery{})
+}
@@ -2,41 +2,79 @@ package query
 
 import (
 	"context"
+	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/internal/probes/ports"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
 )
 
+// LivenessQueryConfig holds configuration for the liveness query handler
+type LivenessQueryConfig struct {
+	// MaxGoroutines is the goroutine count above which the service is dead, zero disables the check
+	MaxGoroutines int
+}
+
 // GetLivenessQuery represents a query to get liveness status
 type GetLivenessQuery struct{}
 
 // GetLivenessQueryHandler handles liveness check queries
 type GetLivenessQueryHandler struct {
 	logger    logger.Logger
+	config    LivenessQueryConfig
+	watchdog  ports.Watchdog
 	startTime time.Time
 }
 
 // NewGetLivenessQueryHandler creates a new liveness query handler
-func NewGetLivenessQueryHandler(logger logger.Logger) *GetLivenessQueryHandler {
+func NewGetLivenessQueryHandler(logger logger.Logger, config LivenessQueryConfig, watchdog ports.Watchdog) *GetLivenessQueryHandler {
 	return &GetLivenessQueryHandler{
 		logger:    logger,
+		config:    config,
+		watchdog:  watchdog,
 		startTime: time.Now().UTC(),
 	}
 }
 
 // Handle executes the liveness check query
 func (h *GetLivenessQueryHandler) Handle(ctx context.Context, query GetLivenessQuery) (*domain.LivenessResponse, error) {
-	h.logger.Debug().Msg("Processing liveness check")
-	
+	log := h.logger.FromContext(ctx)
+	_, span := tracing.StartSpan(ctx, "GetLivenessQueryHandler.Handle")
+	defer span.End()
+
+	log.Debug().Msg("Processing liveness check")
+
+	// Components that missed their heartbeat deadline are wedged
+	failures := h.watchdog.Stalled(time.Now().UTC())
+
+	// A runaway goroutine count usually means a leak that will not recover on its own
+	goroutines := runtime.NumGoroutine()
+	if h.config.MaxGoroutines > 0 && goroutines > h.config.MaxGoroutines {
+		failures = append(failures, domain.LivenessFailure{
+			Component: domain.GoroutinesComponent,
+			Reason:    fmt.Sprintf("%d goroutines exceed the limit of %d", goroutines, h.config.MaxGoroutines),
+		})
+	}
+
 	// Create liveness response with uptime
-	response := domain.NewLivenessResponse(h.startTime)
-	
-	h.logger.Debug().
+	response := domain.NewLivenessResponse(h.startTime, goroutines, failures)
+
+	for _, failure := range response.Failures {
+		log.Error().
+			Str("component", failure.Component).
+			Str("reason", failure.Reason).
+			Msg("Liveness failure detected")
+	}
+
+	log.Debug().
 		Int64("uptime_seconds", response.UptimeSeconds).
+		Int("goroutines", response.Goroutines).
 		Str("status", string(response.Status)).
 		Msg("Liveness check completed")
-	
+
 	return response, nil
 }
 
@@ -56,6 +94,6 @@ func NewLivenessService(logger logger.Logger, queryHandler *GetLivenessQueryHand
 
 // GetLivenessStatus returns the current liveness status
 func (s *LivenessService) GetLivenessStatus(ctx context.Context) (*domain.LivenessResponse, error) {
-	s.logger.Debug().Msg("Liveness status requested")
+	s.logger.FromContext(ctx).Debug().Msg("Liveness status requested")
 	return s.queryHandler.Handle(ctx, GetLivenessQuery{})
-}
\ No newline at end of file
+}
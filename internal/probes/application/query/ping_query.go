@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-clean/internal/probes/domain"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
 )
 
 // Static ping response to avoid creating new instances on every request
@@ -26,7 +27,10 @@ func NewPingQueryHandler(logger logger.Logger) *PingQueryHandler {
 
 // Handle processes the ping query and returns a ping response
 func (h *PingQueryHandler) Handle(ctx context.Context) (*domain.PingResponse, error) {
-	h.logger.Debug().Msg("Processing ping request")
+	_, span := tracing.StartSpan(ctx, "PingQueryHandler.Handle")
+	defer span.End()
+
+	h.logger.FromContext(ctx).Debug().Msg("Processing ping request")
 	// Return the static response for better performance
 	return staticPingResponse, nil
 }
@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetReadinessQuery represents a query to get readiness status
+type GetReadinessQuery struct{}
+
+// GetReadinessQueryHandler handles readiness check queries
+type GetReadinessQueryHandler struct {
+	logger        logger.Logger
+	lifecycle     *domain.Lifecycle
+	healthService *HealthService
+}
+
+// NewGetReadinessQueryHandler creates a new readiness query handler
+func NewGetReadinessQueryHandler(logger logger.Logger, lifecycle *domain.Lifecycle, healthService *HealthService) *GetReadinessQueryHandler {
+	return &GetReadinessQueryHandler{
+		logger:        logger,
+		lifecycle:     lifecycle,
+		healthService: healthService,
+	}
+}
+
+// Handle executes the readiness check query
+func (h *GetReadinessQueryHandler) Handle(ctx context.Context, query GetReadinessQuery) (*domain.ReadinessResponse, error) {
+	log := h.logger.FromContext(ctx)
+	ctx, span := tracing.StartSpan(ctx, "GetReadinessQueryHandler.Handle")
+	defer span.End()
+
+	log.Debug().Msg("Processing readiness check")
+	state, since := h.lifecycle.State()
+
+	// Dependencies are only checked once the service is ready, a starting or
+	// draining service is not ready regardless of its dependencies
+	if state != domain.ReadinessStateReady {
+		response := domain.NewReadinessResponse(state, since, nil)
+		span.SetAttributes(attribute.String("readiness.status", string(response.Status)), attribute.Bool("readiness.ready", response.Ready))
+		log.Debug().Str("status", string(response.Status)).Bool("ready", response.Ready).Msg("Readiness check completed")
+		return response, nil
+	}
+
+	health, err := h.healthService.GetHealthStatus(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check dependencies for readiness")
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	response := domain.NewReadinessResponse(state, since, health)
+	span.SetAttributes(attribute.String("readiness.status", string(response.Status)), attribute.Bool("readiness.ready", response.Ready))
+	log.Debug().
+		Str("status", string(response.Status)).
+		Str("dependencies", string(response.Dependencies)).
+		Bool("ready", response.Ready).
+		Msg("Readiness check completed")
+
+	return response, nil
+}
+
+// ReadinessService implements the readiness service
+type ReadinessService struct {
+	logger       logger.Logger
+	queryHandler *GetReadinessQueryHandler
+}
+
+// NewReadinessService creates a new readiness service
+func NewReadinessService(logger logger.Logger, queryHandler *GetReadinessQueryHandler) *ReadinessService {
+	return &ReadinessService{
+		logger:       logger,
+		queryHandler: queryHandler,
+	}
+}
+
+// GetReadinessStatus returns the current readiness status
+func (s *ReadinessService) GetReadinessStatus(ctx context.Context) (*domain.ReadinessResponse, error) {
+	s.logger.FromContext(ctx).Debug().Msg("Readiness status requested")
+	return s.queryHandler.Handle(ctx, GetReadinessQuery{})
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"context"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/tracing"
+)
+
+// GetStartupQuery represents a query to get startup status
+type GetStartupQuery struct{}
+
+// GetStartupQueryHandler handles startup check queries
+type GetStartupQueryHandler struct {
+	logger    logger.Logger
+	lifecycle *domain.Lifecycle
+}
+
+// NewGetStartupQueryHandler creates a new startup query handler
+func NewGetStartupQueryHandler(logger logger.Logger, lifecycle *domain.Lifecycle) *GetStartupQueryHandler {
+	return &GetStartupQueryHandler{
+		logger:    logger,
+		lifecycle: lifecycle,
+	}
+}
+
+// Handle executes the startup check query
+func (h *GetStartupQueryHandler) Handle(ctx context.Context, query GetStartupQuery) (*domain.StartupResponse, error) {
+	log := h.logger.FromContext(ctx)
+	_, span := tracing.StartSpan(ctx, "GetStartupQueryHandler.Handle")
+	defer span.End()
+
+	log.Debug().Msg("Processing startup check")
+	state, _ := h.lifecycle.State()
+
+	response := domain.NewStartupResponse(state)
+	log.Debug().Str("status", string(response.Status)).Msg("Startup check completed")
+
+	return response, nil
+}
+
+// StartupService implements the startup service
+type StartupService struct {
+	logger       logger.Logger
+	queryHandler *GetStartupQueryHandler
+}
+
+// NewStartupService creates a new startup service
+func NewStartupService(logger logger.Logger, queryHandler *GetStartupQueryHandler) *StartupService {
+	return &StartupService{
+		logger:       logger,
+		queryHandler: queryHandler,
+	}
+}
+
+// GetStartupStatus returns the current startup status
+func (s *StartupService) GetStartupStatus(ctx context.Context) (*domain.StartupResponse, error) {
+	s.logger.FromContext(ctx).Debug().Msg("Startup status requested")
+	return s.queryHandler.Handle(ctx, GetStartupQuery{})
+}
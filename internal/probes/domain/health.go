@@ -7,6 +7,7 @@ type HealthStatus string
 
 const (
 	HealthStatusHealthy   HealthStatus = "healthy"
+	HealthStatusDegraded  HealthStatus = "degraded"
 	HealthStatusUnhealthy HealthStatus = "unhealthy"
 )
 
@@ -18,10 +19,48 @@ const (
 	CheckStatusDown CheckStatus = "down"
 )
 
-// Check represents an individual health check result
+// Check represents an individual health check result. Error, LastSuccess,
+// ConsecutiveFailures and Details are diagnostics that are only exposed to
+// verbose or authenticated callers.
 type Check struct {
-	Status         CheckStatus `json:"status"`
-	ResponseTimeMs int64       `json:"response_time_ms"`
+	Status              CheckStatus    `json:"status"`
+	Critical            bool           `json:"critical"`
+	ResponseTimeMs      int64          `json:"response_time_ms"`
+	Error               string         `json:"error,omitempty"`
+	LastSuccess         *time.Time     `json:"last_success,omitempty"`
+	ConsecutiveFailures int            `json:"consecutive_failures,omitempty"`
+	Details             map[string]any `json:"details,omitempty"`
+}
+
+// IsUp returns true if the check passed
+func (c Check) IsUp() bool {
+	return c.Status == CheckStatusUp
+}
+
+// WithoutDiagnostics returns a copy of the check without its diagnostic fields
+func (c Check) WithoutDiagnostics() Check {
+	return Check{
+		Status:         c.Status,
+		Critical:       c.Critical,
+		ResponseTimeMs: c.ResponseTimeMs,
+	}
+}
+
+// CheckHistory tracks the outcome of consecutive runs of a single check
+type CheckHistory struct {
+	LastSuccess         *time.Time
+	ConsecutiveFailures int
+}
+
+// Record updates the history with the outcome of a check run at the given time
+func (h *CheckHistory) Record(success bool, at time.Time) {
+	if success {
+		lastSuccess := at
+		h.LastSuccess = &lastSuccess
+		h.ConsecutiveFailures = 0
+		return
+	}
+	h.ConsecutiveFailures++
 }
 
 // HealthResponse represents the complete health check response
@@ -29,6 +68,8 @@ type HealthResponse struct {
 	Status    HealthStatus     `json:"status"`
 	Checks    map[string]Check `json:"checks"`
 	Timestamp time.Time        `json:"timestamp"`
+	Cached    bool             `json:"cached,omitempty"`
+	AgeMs     int64            `json:"age_ms,omitempty"`
 }
 
 // NewHealthResponse creates a new health response
@@ -40,25 +81,62 @@ func NewHealthResponse() *HealthResponse {
 }
 
 // AddCheck adds a check result to the health response
-func (hr *HealthResponse) AddCheck(name string, status CheckStatus, responseTimeMs int64) {
-	hr.Checks[name] = Check{
-		Status:         status,
-		ResponseTimeMs: responseTimeMs,
-	}
+func (hr *HealthResponse) AddCheck(name string, check Check) {
+	hr.Checks[name] = check
 }
 
-// DetermineOverallStatus determines the overall health status based on individual checks
+// DetermineOverallStatus determines the overall health status based on individual checks.
+// A failing critical check makes the system unhealthy, while failing non-critical
+// checks only degrade it.
 func (hr *HealthResponse) DetermineOverallStatus() {
+	status := HealthStatusHealthy
 	for _, check := range hr.Checks {
-		if check.Status == CheckStatusDown {
+		if check.Status != CheckStatusDown {
+			continue
+		}
+		if check.Critical {
 			hr.Status = HealthStatusUnhealthy
 			return
 		}
+		status = HealthStatusDegraded
 	}
-	hr.Status = HealthStatusHealthy
+	hr.Status = status
+}
+
+// CachedCopy returns a copy of the response marked as served from cache, with its age relative to now
+func (hr *HealthResponse) CachedCopy(now time.Time) *HealthResponse {
+	checks := make(map[string]Check, len(hr.Checks))
+	for name, check := range hr.Checks {
+		checks[name] = check
+	}
+
+	return &HealthResponse{
+		Status:    hr.Status,
+		Checks:    checks,
+		Timestamp: hr.Timestamp,
+		Cached:    true,
+		AgeMs:     now.Sub(hr.Timestamp).Milliseconds(),
+	}
+}
+
+// WithoutDiagnostics returns a copy of the response with the diagnostics of every check removed
+func (hr *HealthResponse) WithoutDiagnostics() *HealthResponse {
+	checks := make(map[string]Check, len(hr.Checks))
+	for name, check := range hr.Checks {
+		checks[name] = check.WithoutDiagnostics()
+	}
+
+	response := *hr
+	response.Checks = checks
+	return &response
 }
 
 // IsHealthy returns true if the overall status is healthy
 func (hr *HealthResponse) IsHealthy() bool {
 	return hr.Status == HealthStatusHealthy
 }
+
+// IsAvailable returns true if the system can serve traffic, i.e. it is healthy or degraded
+func (hr *HealthResponse) IsAvailable() bool {
+	return hr.Status != HealthStatusUnhealthy
+}
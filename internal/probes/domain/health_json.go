@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// HealthJSONMediaType is the media type of the "Health Check Response Format for HTTP APIs" draft
+const HealthJSONMediaType = "application/health+json"
+
+// HealthJSONStatus represents a status in the health+json format
+type HealthJSONStatus string
+
+const (
+	HealthJSONStatusPass HealthJSONStatus = "pass"
+	HealthJSONStatusWarn HealthJSONStatus = "warn"
+	HealthJSONStatusFail HealthJSONStatus = "fail"
+)
+
+// HealthJSONCheck represents a single measurement of a component in the health+json format
+type HealthJSONCheck struct {
+	ComponentID   string           `json:"componentId,omitempty"`
+	ObservedValue int64            `json:"observedValue"`
+	ObservedUnit  string           `json:"observedUnit"`
+	Status        HealthJSONStatus `json:"status"`
+	Time          time.Time        `json:"time"`
+	Output        string           `json:"output,omitempty"`
+}
+
+// HealthJSONResponse represents the health+json response body
+type HealthJSONResponse struct {
+	Status      HealthJSONStatus             `json:"status"`
+	Version     string                       `json:"version,omitempty"`
+	ReleaseID   string                       `json:"releaseId,omitempty"`
+	ServiceID   string                       `json:"serviceId,omitempty"`
+	Description string                       `json:"description,omitempty"`
+	Checks      map[string][]HealthJSONCheck `json:"checks"`
+}
+
+// ServiceIdentity identifies the service in health+json responses
+type ServiceIdentity struct {
+	ServiceID string
+	ReleaseID string
+}
+
+// NewHealthJSONResponse converts a health response into the health+json format.
+// Checks are keyed by "component:measurement", the only measurement reported is
+// the response time in milliseconds.
+func NewHealthJSONResponse(hr *HealthResponse, identity ServiceIdentity) *HealthJSONResponse {
+	response := &HealthJSONResponse{
+		Status:      healthJSONStatus(hr.Status),
+		Version:     majorVersion(identity.ReleaseID),
+		ReleaseID:   identity.ReleaseID,
+		ServiceID:   identity.ServiceID,
+		Description: "health of " + identity.ServiceID,
+		Checks:      make(map[string][]HealthJSONCheck, len(hr.Checks)),
+	}
+
+	for name, check := range hr.Checks {
+		response.Checks[name+":responseTime"] = []HealthJSONCheck{{
+			ComponentID:   name,
+			ObservedValue: check.ResponseTimeMs,
+			ObservedUnit:  "ms",
+			Status:        checkJSONStatus(check),
+			Time:          hr.Timestamp,
+			Output:        check.Error,
+		}}
+	}
+
+	return response
+}
+
+// healthJSONStatus maps the overall health status to a health+json status
+func healthJSONStatus(status HealthStatus) HealthJSONStatus {
+	switch status {
+	case HealthStatusHealthy:
+		return HealthJSONStatusPass
+	case HealthStatusDegraded:
+		return HealthJSONStatusWarn
+	default:
+		return HealthJSONStatusFail
+	}
+}
+
+// checkJSONStatus maps a check to a health+json status, failing non-critical checks only warn
+func checkJSONStatus(check Check) HealthJSONStatus {
+	switch {
+	case check.Status == CheckStatusUp:
+		return HealthJSONStatusPass
+	case check.Critical:
+		return HealthJSONStatusFail
+	default:
+		return HealthJSONStatusWarn
+	}
+}
+
+// majorVersion returns the major part of a semantic version (e.g. "1" for "1.4.2")
+func majorVersion(version string) string {
+	version = strings.TrimPrefix(version, "v")
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewHealthJSONResponse(t *testing.T) {
+	checkedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	identity := ServiceIdentity{ServiceID: "go-clean", ReleaseID: "v1.4.2"}
+
+	tests := []struct {
+		name   string
+		status HealthStatus
+		checks map[string]Check
+		want   HealthJSONStatus
+		// wantChecks maps the expected "component:measurement" keys to their status
+		wantChecks map[string]HealthJSONStatus
+	}{
+		{
+			name:   "healthy",
+			status: HealthStatusHealthy,
+			checks: map[string]Check{
+				"database": {Status: CheckStatusUp, Critical: true, ResponseTimeMs: 3},
+				"redis":    {Status: CheckStatusUp, ResponseTimeMs: 1},
+			},
+			want:       HealthJSONStatusPass,
+			wantChecks: map[string]HealthJSONStatus{"database:responseTime": HealthJSONStatusPass, "redis:responseTime": HealthJSONStatusPass},
+		},
+		{
+			name:   "degraded",
+			status: HealthStatusDegraded,
+			checks: map[string]Check{
+				"database": {Status: CheckStatusUp, Critical: true},
+				"redis":    {Status: CheckStatusDown, Error: "connection refused"},
+			},
+			want:       HealthJSONStatusWarn,
+			wantChecks: map[string]HealthJSONStatus{"database:responseTime": HealthJSONStatusPass, "redis:responseTime": HealthJSONStatusWarn},
+		},
+		{
+			name:   "unhealthy",
+			status: HealthStatusUnhealthy,
+			checks: map[string]Check{
+				"database": {Status: CheckStatusDown, Critical: true, Error: "connection refused"},
+			},
+			want:       HealthJSONStatusFail,
+			wantChecks: map[string]HealthJSONStatus{"database:responseTime": HealthJSONStatusFail},
+		},
+		{
+			name:       "no checks",
+			status:     HealthStatusHealthy,
+			checks:     map[string]Check{},
+			want:       HealthJSONStatusPass,
+			wantChecks: map[string]HealthJSONStatus{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := NewHealthJSONResponse(&HealthResponse{Status: tt.status, Checks: tt.checks, Timestamp: checkedAt}, identity)
+
+			if response.Status != tt.want {
+				t.Errorf("status = %s, want %s", response.Status, tt.want)
+			}
+			if response.ServiceID != "go-clean" || response.ReleaseID != "v1.4.2" || response.Version != "1" {
+				t.Errorf("service %q, release %q, version %q, want go-clean, v1.4.2 and 1", response.ServiceID, response.ReleaseID, response.Version)
+			}
+			if len(response.Checks) != len(tt.wantChecks) {
+				t.Fatalf("checks = %v, want keys %v", response.Checks, tt.wantChecks)
+			}
+			for key, wantStatus := range tt.wantChecks {
+				measurements := response.Checks[key]
+				if len(measurements) != 1 {
+					t.Fatalf("%s has %d measurements, want 1", key, len(measurements))
+				}
+				got := measurements[0]
+				name := key[:len(key)-len(":responseTime")]
+				source := tt.checks[name]
+				if got.Status != wantStatus {
+					t.Errorf("%s status = %s, want %s", key, got.Status, wantStatus)
+				}
+				if got.ComponentID != name || got.ObservedValue != source.ResponseTimeMs || got.ObservedUnit != "ms" {
+					t.Errorf("%s = %+v, want component %s observed %dms", key, got, name, source.ResponseTimeMs)
+				}
+				if got.Output != source.Error || !got.Time.Equal(checkedAt) {
+					t.Errorf("%s output %q at %s, want %q at %s", key, got.Output, got.Time, source.Error, checkedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestMajorVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"1.4.2", "1"},
+		{"v2.0.0", "2"},
+		{"3", "3"},
+		{"dev", "dev"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := majorVersion(tt.version); got != tt.want {
+			t.Errorf("majorVersion(%q) = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetermineOverallStatus(t *testing.T) {
+	up := Check{Status: CheckStatusUp}
+	criticalUp := Check{Status: CheckStatusUp, Critical: true}
+	down := Check{Status: CheckStatusDown}
+	criticalDown := Check{Status: CheckStatusDown, Critical: true}
+
+	tests := []struct {
+		name          string
+		checks        map[string]Check
+		want          HealthStatus
+		wantAvailable bool
+	}{
+		{name: "no checks", checks: map[string]Check{}, want: HealthStatusHealthy, wantAvailable: true},
+		{name: "all up", checks: map[string]Check{"database": criticalUp, "redis": up}, want: HealthStatusHealthy, wantAvailable: true},
+		{name: "non-critical down", checks: map[string]Check{"database": criticalUp, "redis": down}, want: HealthStatusDegraded, wantAvailable: true},
+		{name: "critical down", checks: map[string]Check{"database": criticalDown, "redis": up}, want: HealthStatusUnhealthy},
+		{name: "critical and non-critical down", checks: map[string]Check{"database": criticalDown, "redis": down}, want: HealthStatusUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := NewHealthResponse()
+			for name, check := range tt.checks {
+				response.AddCheck(name, check)
+			}
+			response.DetermineOverallStatus()
+
+			if response.Status != tt.want {
+				t.Errorf("status = %s, want %s", response.Status, tt.want)
+			}
+			if got := response.IsHealthy(); got != (tt.want == HealthStatusHealthy) {
+				t.Errorf("IsHealthy() = %t for status %s", got, tt.want)
+			}
+			if got := response.IsAvailable(); got != tt.wantAvailable {
+				t.Errorf("IsAvailable() = %t, want %t", got, tt.wantAvailable)
+			}
+		})
+	}
+}
+
+func TestCachedCopy(t *testing.T) {
+	checkedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	response := &HealthResponse{
+		Status:    HealthStatusHealthy,
+		Checks:    map[string]Check{"database": {Status: CheckStatusUp}},
+		Timestamp: checkedAt,
+	}
+
+	cached := response.CachedCopy(checkedAt.Add(1500 * time.Millisecond))
+	if !cached.Cached || cached.AgeMs != 1500 {
+		t.Errorf("cached = %t, age = %dms, want true and 1500ms", cached.Cached, cached.AgeMs)
+	}
+	if !cached.Timestamp.Equal(checkedAt) {
+		t.Errorf("timestamp = %s, want the time of the check", cached.Timestamp)
+	}
+
+	// The copy must not share its checks with the cached response
+	cached.Checks["redis"] = Check{Status: CheckStatusDown}
+	if len(response.Checks) != 1 || response.Cached {
+		t.Error("changing the copy changed the cached response")
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// Dependency describes a Go module the service was built with
+type Dependency struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+}
+
+// BuildInfo holds the build metadata of the running binary
+type BuildInfo struct {
+	Version      string       `json:"version,omitempty"`
+	Revision     string       `json:"revision,omitempty"`
+	RevisionTime string       `json:"revision_time,omitempty"`
+	Modified     bool         `json:"modified,omitempty"`
+	BuildTime    string       `json:"build_time,omitempty"`
+	GoVersion    string       `json:"go_version"`
+	Module       string       `json:"module,omitempty"`
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+	StartTime    time.Time    `json:"-"`
+}
+
+// InfoResponse represents the build and runtime information response
+type InfoResponse struct {
+	Name          string    `json:"name"`
+	Version       string    `json:"version"`
+	Environment   string    `json:"environment"`
+	Build         BuildInfo `json:"build"`
+	StartTime     time.Time `json:"start_time"`
+	UptimeSeconds int64     `json:"uptime_seconds"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// NewInfoResponse creates a new info response. A version injected at build
+// time takes precedence over the configured application version.
+func NewInfoResponse(name, version, environment string, build BuildInfo) *InfoResponse {
+	now := time.Now().UTC()
+
+	if build.Version != "" {
+		version = build.Version
+	}
+
+	return &InfoResponse{
+		Name:          name,
+		Version:       version,
+		Environment:   environment,
+		Build:         build,
+		StartTime:     build.StartTime,
+		UptimeSeconds: int64(now.Sub(build.StartTime).Seconds()),
+		Timestamp:     now,
+	}
+}
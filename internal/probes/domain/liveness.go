@@ -10,21 +10,40 @@ const (
 	LivenessStatusDead  LivenessStatus = "dead"
 )
 
+// GoroutinesComponent is the component name reported when the goroutine count exceeds its threshold
+const GoroutinesComponent = "goroutines"
+
+// LivenessFailure describes a component that makes the service dead
+type LivenessFailure struct {
+	Component     string     `json:"component"`
+	Reason        string     `json:"reason"`
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+}
+
 // LivenessResponse represents the liveness check response
 type LivenessResponse struct {
-	Status        LivenessStatus `json:"status"`
-	UptimeSeconds int64          `json:"uptime_seconds"`
-	Timestamp     time.Time      `json:"timestamp"`
+	Status        LivenessStatus    `json:"status"`
+	UptimeSeconds int64             `json:"uptime_seconds"`
+	Goroutines    int               `json:"goroutines"`
+	Failures      []LivenessFailure `json:"failures,omitempty"`
+	Timestamp     time.Time         `json:"timestamp"`
 }
 
-// NewLivenessResponse creates a new liveness response
-func NewLivenessResponse(startTime time.Time) *LivenessResponse {
+// NewLivenessResponse creates a new liveness response, the service is dead when any failure is reported
+func NewLivenessResponse(startTime time.Time, goroutines int, failures []LivenessFailure) *LivenessResponse {
 	now := time.Now().UTC()
 	uptime := now.Sub(startTime)
-	
+
+	status := LivenessStatusAlive
+	if len(failures) > 0 {
+		status = LivenessStatusDead
+	}
+
 	return &LivenessResponse{
-		Status:        LivenessStatusAlive,
+		Status:        status,
 		UptimeSeconds: int64(uptime.Seconds()),
+		Goroutines:    goroutines,
+		Failures:      failures,
 		Timestamp:     now,
 	}
 }
@@ -32,4 +51,4 @@ func NewLivenessResponse(startTime time.Time) *LivenessResponse {
 // IsAlive returns true if the service is alive
 func (lr *LivenessResponse) IsAlive() bool {
 	return lr.Status == LivenessStatusAlive
-}
\ No newline at end of file
+}
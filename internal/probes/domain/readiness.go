@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ReadinessState represents the lifecycle state of the service
+type ReadinessState string
+
+const (
+	ReadinessStateStarting ReadinessState = "starting"
+	ReadinessStateReady    ReadinessState = "ready"
+	ReadinessStateDraining ReadinessState = "draining"
+)
+
+// CanTransitionTo reports whether the state machine allows moving to the next state.
+// The only valid path is starting → ready → draining, draining may also be entered
+// directly from starting when the service is stopped before it became ready.
+func (s ReadinessState) CanTransitionTo(next ReadinessState) bool {
+	switch s {
+	case ReadinessStateStarting:
+		return next == ReadinessStateReady || next == ReadinessStateDraining
+	case ReadinessStateReady:
+		return next == ReadinessStateDraining
+	default:
+		return false
+	}
+}
+
+// Lifecycle is the readiness state machine of the service
+type Lifecycle struct {
+	mu        sync.RWMutex
+	state     ReadinessState
+	changedAt time.Time
+}
+
+// NewLifecycle creates a new lifecycle in the starting state
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{
+		state:     ReadinessStateStarting,
+		changedAt: time.Now().UTC(),
+	}
+}
+
+// State returns the current lifecycle state and when it was entered
+func (l *Lifecycle) State() (ReadinessState, time.Time) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.state, l.changedAt
+}
+
+// TransitionTo moves the lifecycle to the next state if the transition is allowed
+func (l *Lifecycle) TransitionTo(next ReadinessState) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.state == next {
+		return nil
+	}
+	if !l.state.CanTransitionTo(next) {
+		return fmt.Errorf("invalid readiness transition from %s to %s", l.state, next)
+	}
+
+	l.state = next
+	l.changedAt = time.Now().UTC()
+	return nil
+}
+
+// ReadinessResponse represents the readiness check response
+type ReadinessResponse struct {
+	Status       ReadinessState `json:"status"`
+	Ready        bool           `json:"ready"`
+	Dependencies HealthStatus   `json:"dependencies,omitempty"`
+	Since        time.Time      `json:"since"`
+	Timestamp    time.Time      `json:"timestamp"`
+}
+
+// NewReadinessResponse creates a new readiness response. The service is ready only
+// when the lifecycle is ready and the dependencies (if checked) can serve traffic.
+func NewReadinessResponse(state ReadinessState, since time.Time, health *HealthResponse) *ReadinessResponse {
+	response := &ReadinessResponse{
+		Status:    state,
+		Ready:     state == ReadinessStateReady,
+		Since:     since,
+		Timestamp: time.Now().UTC(),
+	}
+
+	if health != nil {
+		response.Dependencies = health.Status
+		response.Ready = response.Ready && health.IsAvailable()
+	}
+
+	return response
+}
+
+// IsReady returns true if the service should receive traffic
+func (rr *ReadinessResponse) IsReady() bool {
+	return rr.Ready
+}
+
+// StartupStatus represents the startup status of the service
+type StartupStatus string
+
+const (
+	StartupStatusStarting StartupStatus = "starting"
+	StartupStatusStarted  StartupStatus = "started"
+)
+
+// StartupResponse represents the startup check response
+type StartupResponse struct {
+	Status    StartupStatus `json:"status"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+// NewStartupResponse creates a new startup response. Once the service has left the
+// starting state it stays started, including while draining.
+func NewStartupResponse(state ReadinessState) *StartupResponse {
+	status := StartupStatusStarted
+	if state == ReadinessStateStarting {
+		status = StartupStatusStarting
+	}
+
+	return &StartupResponse{
+		Status:    status,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// IsStarted returns true if the service has completed startup
+func (sr *StartupResponse) IsStarted() bool {
+	return sr.Status == StartupStatusStarted
+}
@@ -0,0 +1,90 @@
+package domain
+
+import "testing"
+
+func TestLifecycleTransitions(t *testing.T) {
+	tests := []struct {
+		name string
+		// path lists the states moved to after starting
+		path      []ReadinessState
+		wantState ReadinessState
+		// wantErr is the index of the first rejected transition, -1 when all succeed
+		wantErr int
+	}{
+		{name: "starting to ready", path: []ReadinessState{ReadinessStateReady}, wantState: ReadinessStateReady, wantErr: -1},
+		{name: "ready to draining", path: []ReadinessState{ReadinessStateReady, ReadinessStateDraining}, wantState: ReadinessStateDraining, wantErr: -1},
+		{name: "stopped before ready", path: []ReadinessState{ReadinessStateDraining}, wantState: ReadinessStateDraining, wantErr: -1},
+		{name: "same state is a no-op", path: []ReadinessState{ReadinessStateReady, ReadinessStateReady}, wantState: ReadinessStateReady, wantErr: -1},
+		{name: "draining is final", path: []ReadinessState{ReadinessStateDraining, ReadinessStateReady}, wantState: ReadinessStateDraining, wantErr: 1},
+		{name: "no way back to starting", path: []ReadinessState{ReadinessStateReady, ReadinessStateStarting}, wantState: ReadinessStateReady, wantErr: 1},
+		{name: "unknown state", path: []ReadinessState{"stopped"}, wantState: ReadinessStateStarting, wantErr: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifecycle := NewLifecycle()
+			_, since := lifecycle.State()
+
+			for i, next := range tt.path {
+				err := lifecycle.TransitionTo(next)
+				if (err != nil) != (i == tt.wantErr) {
+					t.Fatalf("transition %d to %s: err = %v, want error %t", i, next, err, i == tt.wantErr)
+				}
+			}
+
+			state, changedAt := lifecycle.State()
+			if state != tt.wantState {
+				t.Errorf("state = %s, want %s", state, tt.wantState)
+			}
+			if changedAt.Before(since) {
+				t.Errorf("changed at %s, before the lifecycle was created at %s", changedAt, since)
+			}
+		})
+	}
+}
+
+func TestNewReadinessResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     ReadinessState
+		health    HealthStatus
+		wantReady bool
+	}{
+		{name: "ready without dependency checks", state: ReadinessStateReady, wantReady: true},
+		{name: "ready with healthy dependencies", state: ReadinessStateReady, health: HealthStatusHealthy, wantReady: true},
+		{name: "ready with degraded dependencies", state: ReadinessStateReady, health: HealthStatusDegraded, wantReady: true},
+		{name: "ready with unhealthy dependencies", state: ReadinessStateReady, health: HealthStatusUnhealthy},
+		{name: "starting", state: ReadinessStateStarting, health: HealthStatusHealthy},
+		{name: "draining", state: ReadinessStateDraining, health: HealthStatusHealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var health *HealthResponse
+			if tt.health != "" {
+				health = &HealthResponse{Status: tt.health}
+			}
+
+			response := NewReadinessResponse(tt.state, NewLifecycle().changedAt, health)
+			if response.IsReady() != tt.wantReady {
+				t.Errorf("IsReady() = %t, want %t", response.IsReady(), tt.wantReady)
+			}
+			if response.Dependencies != tt.health {
+				t.Errorf("dependencies = %q, want %q", response.Dependencies, tt.health)
+			}
+		})
+	}
+}
+
+func TestNewStartupResponse(t *testing.T) {
+	for state, want := range map[ReadinessState]bool{
+		ReadinessStateStarting: false,
+		ReadinessStateReady:    true,
+		// Draining must not fail the startup probe, or the kubelet restarts a stopping pod
+		ReadinessStateDraining: true,
+	} {
+		if got := NewStartupResponse(state).IsStarted(); got != want {
+			t.Errorf("IsStarted() in %s = %t, want %t", state, got, want)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package infrastructure
+
+import (
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/platform/buildinfo"
+)
+
+// BuildInfoProvider implements the BuildInfoProvider port using the Go build metadata
+type BuildInfoProvider struct{}
+
+// NewBuildInfoProvider creates a new build info provider
+func NewBuildInfoProvider() *BuildInfoProvider {
+	return &BuildInfoProvider{}
+}
+
+// BuildInfo returns the build metadata of the running binary
+func (p *BuildInfoProvider) BuildInfo() domain.BuildInfo {
+	info := buildinfo.Read()
+
+	dependencies := make([]domain.Dependency, 0, len(info.Dependencies))
+	for _, dep := range info.Dependencies {
+		dependencies = append(dependencies, domain.Dependency{Path: dep.Path, Version: dep.Version})
+	}
+
+	return domain.BuildInfo{
+		Version:      info.Version,
+		Revision:     info.Revision,
+		RevisionTime: info.RevisionTime,
+		Modified:     info.Modified,
+		BuildTime:    info.BuildTime,
+		GoVersion:    info.GoVersion,
+		Module:       info.Path,
+		Dependencies: dependencies,
+		StartTime:    info.StartTime,
+	}
+}
@@ -8,38 +8,73 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// DatabaseChecker implements the DatabaseChecker port
+// DatabaseChecker implements the HealthChecker port for PostgreSQL
 type DatabaseChecker struct {
-	logger logger.Logger
-	db     *pgxpool.Pool
+	logger   logger.Logger
+	db       *pgxpool.Pool
+	timeout  time.Duration
+	critical bool
 }
 
 // NewDatabaseChecker creates a new database checker
-func NewDatabaseChecker(logger logger.Logger, db *pgxpool.Pool) *DatabaseChecker {
+func NewDatabaseChecker(logger logger.Logger, db *pgxpool.Pool, timeout time.Duration, critical bool) *DatabaseChecker {
 	return &DatabaseChecker{
-		logger: logger,
-		db:     db,
+		logger:   logger,
+		db:       db,
+		timeout:  timeout,
+		critical: critical,
 	}
 }
 
-// CheckDatabase checks the database connectivity and response time
-func (dc *DatabaseChecker) CheckDatabase(ctx context.Context) (bool, time.Duration, error) {
-	dc.logger.Debug().Msg("Starting database connectivity check")
-	start := time.Now()
+// Name returns the name the database check is reported under
+func (dc *DatabaseChecker) Name() string {
+	return "database"
+}
+
+// Critical reports whether a database failure makes the service unhealthy
+func (dc *DatabaseChecker) Critical() bool {
+	return dc.critical
+}
+
+// Timeout returns the configured database check timeout
+func (dc *DatabaseChecker) Timeout() time.Duration {
+	return dc.timeout
+}
 
-	// Create a context with timeout for the health check
-	checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
+// Check checks the database connectivity
+func (dc *DatabaseChecker) Check(ctx context.Context) error {
+	log := dc.logger.FromContext(ctx)
+	log.Debug().Msg("Starting database connectivity check")
+	start := time.Now()
 
 	// Simple ping to check database connectivity
-	err := dc.db.Ping(checkCtx)
+	err := dc.db.Ping(ctx)
 	duration := time.Since(start)
 
 	if err != nil {
-		dc.logger.Error().Err(err).Int64("duration_ms", duration.Milliseconds()).Msg("Database ping failed")
-		return false, duration, err
+		log.Error().Err(err).Dur("duration_ms", duration).Msg("Database ping failed")
+		return err
 	}
 
-	dc.logger.Debug().Int64("duration_ms", duration.Milliseconds()).Msg("Database ping successful")
-	return true, duration, nil
+	log.Debug().Dur("duration_ms", duration).Msg("Database ping successful")
+	return nil
+}
+
+// Details reports the connection pool statistics of the database
+func (dc *DatabaseChecker) Details(ctx context.Context) map[string]any {
+	stat := dc.db.Stat()
+	return map[string]any{
+		"max_conns":                  stat.MaxConns(),
+		"total_conns":                stat.TotalConns(),
+		"idle_conns":                 stat.IdleConns(),
+		"acquired_conns":             stat.AcquiredConns(),
+		"constructing_conns":         stat.ConstructingConns(),
+		"acquire_count":              stat.AcquireCount(),
+		"acquire_duration_ms":        stat.AcquireDuration().Milliseconds(),
+		"empty_acquire_count":        stat.EmptyAcquireCount(),
+		"canceled_acquire_count":     stat.CanceledAcquireCount(),
+		"new_conns_count":            stat.NewConnsCount(),
+		"max_lifetime_destroy_count": stat.MaxLifetimeDestroyCount(),
+		"max_idle_destroy_count":     stat.MaxIdleDestroyCount(),
+	}
 }
@@ -0,0 +1,73 @@
+package infrastructure
+
+import (
+	"strconv"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/platform/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusHealthMetrics implements the HealthMetricsRecorder port with Prometheus gauges
+type PrometheusHealthMetrics struct {
+	checkUp             *prometheus.GaugeVec
+	checkResponseTime   *prometheus.GaugeVec
+	consecutiveFailures *prometheus.GaugeVec
+	status              *prometheus.GaugeVec
+}
+
+// NewPrometheusHealthMetrics creates the health gauges and registers them with the metrics registry
+func NewPrometheusHealthMetrics(registry *metrics.Registry) (*PrometheusHealthMetrics, error) {
+	m := &PrometheusHealthMetrics{
+		checkUp: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: registry.Namespace(),
+			Subsystem: "health",
+			Name:      "check_up",
+			Help:      "Whether the last run of the health check passed (1) or failed (0).",
+		}, []string{"check", "critical"}),
+		checkResponseTime: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: registry.Namespace(),
+			Subsystem: "health",
+			Name:      "check_response_time_seconds",
+			Help:      "Response time of the last run of the health check.",
+		}, []string{"check"}),
+		consecutiveFailures: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: registry.Namespace(),
+			Subsystem: "health",
+			Name:      "check_consecutive_failures",
+			Help:      "Number of consecutive failed runs of the health check.",
+		}, []string{"check"}),
+		status: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: registry.Namespace(),
+			Subsystem: "health",
+			Name:      "status",
+			Help:      "Overall health status, 1 for the current status and 0 for the others.",
+		}, []string{"status"}),
+	}
+
+	if err := registry.Register(m.checkUp, m.checkResponseTime, m.consecutiveFailures, m.status); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RecordHealth updates the gauges from a health check response
+func (m *PrometheusHealthMetrics) RecordHealth(response *domain.HealthResponse) {
+	for name, check := range response.Checks {
+		up := 0.0
+		if check.IsUp() {
+			up = 1
+		}
+		m.checkUp.WithLabelValues(name, strconv.FormatBool(check.Critical)).Set(up)
+		m.checkResponseTime.WithLabelValues(name).Set(float64(check.ResponseTimeMs) / 1000)
+		m.consecutiveFailures.WithLabelValues(name).Set(float64(check.ConsecutiveFailures))
+	}
+
+	for _, status := range []domain.HealthStatus{domain.HealthStatusHealthy, domain.HealthStatusDegraded, domain.HealthStatusUnhealthy} {
+		value := 0.0
+		if response.Status == status {
+			value = 1
+		}
+		m.status.WithLabelValues(string(status)).Set(value)
+	}
+}
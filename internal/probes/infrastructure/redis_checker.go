@@ -2,44 +2,107 @@ package infrastructure
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-clean/platform/logger"
 	"github.com/redis/go-redis/v9"
 )
 
-// RedisChecker implements the RedisChecker port
+// RedisChecker implements the HealthChecker port for Redis
 type RedisChecker struct {
-	logger logger.Logger
-	client *redis.Client
+	logger   logger.Logger
+	client   *redis.Client
+	timeout  time.Duration
+	critical bool
+
+	versionMu     sync.Mutex
+	serverVersion string
 }
 
 // NewRedisChecker creates a new Redis checker
-func NewRedisChecker(logger logger.Logger, client *redis.Client) *RedisChecker {
+func NewRedisChecker(logger logger.Logger, client *redis.Client, timeout time.Duration, critical bool) *RedisChecker {
 	return &RedisChecker{
-		logger: logger,
-		client: client,
+		logger:   logger,
+		client:   client,
+		timeout:  timeout,
+		critical: critical,
 	}
 }
 
-// CheckRedis checks the Redis connectivity and response time
-func (rc *RedisChecker) CheckRedis(ctx context.Context) (bool, time.Duration, error) {
-	rc.logger.Debug().Msg("Starting Redis connectivity check")
-	start := time.Now()
+// Name returns the name the Redis check is reported under
+func (rc *RedisChecker) Name() string {
+	return "redis"
+}
 
-	// Create a context with timeout for the health check
-	checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
+// Critical reports whether a Redis failure makes the service unhealthy
+func (rc *RedisChecker) Critical() bool {
+	return rc.critical
+}
+
+// Timeout returns the configured Redis check timeout
+func (rc *RedisChecker) Timeout() time.Duration {
+	return rc.timeout
+}
+
+// Check checks the Redis connectivity
+func (rc *RedisChecker) Check(ctx context.Context) error {
+	log := rc.logger.FromContext(ctx)
+	log.Debug().Msg("Starting Redis connectivity check")
+	start := time.Now()
 
 	// Simple ping to check Redis connectivity
-	result := rc.client.Ping(checkCtx)
+	result := rc.client.Ping(ctx)
 	duration := time.Since(start)
 
 	if err := result.Err(); err != nil {
-		rc.logger.Error().Err(err).Int64("duration_ms", duration.Milliseconds()).Msg("Redis ping failed")
-		return false, duration, err
+		log.Error().Err(err).Dur("duration_ms", duration).Msg("Redis ping failed")
+		return err
 	}
 
-	rc.logger.Debug().Int64("duration_ms", duration.Milliseconds()).Msg("Redis ping successful")
-	return true, duration, nil
+	log.Debug().Dur("duration_ms", duration).Msg("Redis ping successful")
+	return nil
+}
+
+// Details reports the connection pool statistics and server version of Redis
+func (rc *RedisChecker) Details(ctx context.Context) map[string]any {
+	stats := rc.client.PoolStats()
+	details := map[string]any{
+		"hits":        stats.Hits,
+		"misses":      stats.Misses,
+		"timeouts":    stats.Timeouts,
+		"total_conns": stats.TotalConns,
+		"idle_conns":  stats.IdleConns,
+		"stale_conns": stats.StaleConns,
+	}
+
+	if version := rc.version(ctx); version != "" {
+		details["server_version"] = version
+	}
+	return details
+}
+
+// version returns the Redis server version, querying it once and caching the result
+func (rc *RedisChecker) version(ctx context.Context) string {
+	rc.versionMu.Lock()
+	defer rc.versionMu.Unlock()
+
+	if rc.serverVersion != "" {
+		return rc.serverVersion
+	}
+
+	info, err := rc.client.Info(ctx, "server").Result()
+	if err != nil {
+		rc.logger.FromContext(ctx).Debug().Err(err).Msg("Failed to read Redis server info")
+		return ""
+	}
+
+	for _, line := range strings.Split(info, "\n") {
+		if version, ok := strings.CutPrefix(strings.TrimSpace(line), "redis_version:"); ok {
+			rc.serverVersion = version
+			break
+		}
+	}
+	return rc.serverVersion
 }
@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/internal/probes/ports"
+	"github.com/go-clean/platform/watchdog"
+)
+
+// WatchdogAdapter implements the Watchdog port using the platform watchdog
+type WatchdogAdapter struct {
+	watchdog *watchdog.Watchdog
+}
+
+// NewWatchdogAdapter creates a new watchdog adapter
+func NewWatchdogAdapter(w *watchdog.Watchdog) *WatchdogAdapter {
+	return &WatchdogAdapter{watchdog: w}
+}
+
+// Register registers a component that must beat at least once per timeout
+func (a *WatchdogAdapter) Register(name string, timeout time.Duration) (ports.Heartbeat, error) {
+	heartbeat, err := a.watchdog.Register(name, timeout)
+	if err != nil {
+		// Returning the nil *watchdog.Heartbeat would yield a non-nil ports.Heartbeat
+		return nil, err
+	}
+	return heartbeat, nil
+}
+
+// Stalled returns the components whose heartbeat missed its deadline
+func (a *WatchdogAdapter) Stalled(now time.Time) []domain.LivenessFailure {
+	stalls := a.watchdog.Stalled(now)
+
+	failures := make([]domain.LivenessFailure, 0, len(stalls))
+	for _, stall := range stalls {
+		lastHeartbeat := stall.LastHeartbeat
+		failures = append(failures, domain.LivenessFailure{
+			Component:     stall.Name,
+			Reason:        fmt.Sprintf("no heartbeat for %s (timeout %s)", now.Sub(lastHeartbeat).Round(time.Millisecond), stall.Timeout),
+			LastHeartbeat: &lastHeartbeat,
+		})
+	}
+	return failures
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "github.com/go-clean/internal/probes/domain"
+
+// BuildInfoProvider defines the interface for reading build and runtime
+// metadata of the running binary
+type BuildInfoProvider interface {
+	BuildInfo() domain.BuildInfo
+}
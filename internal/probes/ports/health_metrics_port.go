@@ -0,0 +1,9 @@
+package ports
+
+import "github.com/go-clean/internal/probes/domain"
+
+// HealthMetricsRecorder defines the interface for exporting health check results as metrics
+type HealthMetricsRecorder interface {
+	// RecordHealth records the outcome of a health check run
+	RecordHealth(response *domain.HealthResponse)
+}
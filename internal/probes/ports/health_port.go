@@ -5,12 +5,27 @@ import (
 	"time"
 )
 
-// DatabaseChecker defines the interface for checking database connectivity
-type DatabaseChecker interface {
-	CheckDatabase(ctx context.Context) (bool, time.Duration, error)
+// HealthChecker defines the interface for a single dependency health check.
+// Any module can contribute an implementation to the health checker registry
+// so that it is reported by the health endpoint.
+type HealthChecker interface {
+	// Name returns the unique name the check is reported under (e.g. "database")
+	Name() string
+
+	// Critical reports whether a failure of this check makes the service unhealthy
+	Critical() bool
+
+	// Timeout returns the maximum duration a single check may take, zero means
+	// the check is only bounded by the overall health check deadline
+	Timeout() time.Duration
+
+	// Check verifies the dependency and returns an error if it is not reachable
+	Check(ctx context.Context) error
 }
 
-// RedisChecker defines the interface for checking Redis connectivity
-type RedisChecker interface {
-	CheckRedis(ctx context.Context) (bool, time.Duration, error)
+// HealthDetailsProvider is an optional interface a HealthChecker can implement
+// to report dependency-specific diagnostics (e.g. pool statistics, server version),
+// only collected for callers allowed to see them
+type HealthDetailsProvider interface {
+	Details(ctx context.Context) map[string]any
 }
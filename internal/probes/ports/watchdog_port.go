@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/go-clean/internal/probes/domain"
+)
+
+// Heartbeat is the handle a long-running component uses to signal progress
+type Heartbeat interface {
+	// Beat records that the component is making progress
+	Beat()
+
+	// Stop unregisters the heartbeat when the component shuts down cleanly
+	Stop()
+}
+
+// Watchdog defines the interface for tracking heartbeats of long-running components
+type Watchdog interface {
+	// Register registers a component that must beat at least once per timeout
+	Register(name string, timeout time.Duration) (Heartbeat, error)
+
+	// Stalled returns the components whose heartbeat missed its deadline
+	Stalled(now time.Time) []domain.LivenessFailure
+}
@@ -0,0 +1,365 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-clean/internal/probes/application/query"
+	"github.com/go-clean/internal/probes/ports"
+	"github.com/go-clean/platform/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// watchLoopComponent is the name the watch loop heartbeat is registered under
+const watchLoopComponent = "grpc_health_watch"
+
+const (
+	// ServiceLiveness is the health service name reporting the liveness status
+	ServiceLiveness = "liveness"
+	// ServiceReadiness is the health service name reporting the readiness status,
+	// it is also reported for the empty (overall) service name
+	ServiceReadiness = "readiness"
+)
+
+// HealthServerConfig holds configuration for the gRPC health server
+type HealthServerConfig struct {
+	// WatchInterval is how often the statuses of watched services are re-evaluated,
+	// once per service whatever the number of Watch streams
+	WatchInterval time.Duration
+	// HeartbeatTimeout is how long a refresh may take before the liveness probe reports the watch loop as wedged
+	HeartbeatTimeout time.Duration
+}
+
+// HealthServer implements the grpc.health.v1.Health protocol on top of the probes services
+type HealthServer struct {
+	healthpb.UnimplementedHealthServer
+
+	logger           logger.Logger
+	config           HealthServerConfig
+	healthService    *query.HealthService
+	livenessService  *query.LivenessService
+	readinessService *query.ReadinessService
+	watchdog         ports.Watchdog
+
+	// watchers and statuses hold the Watch streams and the last evaluated status
+	// of every watched service
+	mu       sync.Mutex
+	watchers map[string]map[*watcher]struct{}
+	statuses map[string]healthpb.HealthCheckResponse_ServingStatus
+
+	started   atomic.Bool
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewHealthServer creates a new gRPC health server
+func NewHealthServer(
+	logger logger.Logger,
+	config HealthServerConfig,
+	healthService *query.HealthService,
+	livenessService *query.LivenessService,
+	readinessService *query.ReadinessService,
+	watchdog ports.Watchdog,
+) *HealthServer {
+	return &HealthServer{
+		logger:           logger,
+		config:           config,
+		healthService:    healthService,
+		livenessService:  livenessService,
+		readinessService: readinessService,
+		watchdog:         watchdog,
+		watchers:         make(map[string]map[*watcher]struct{}),
+		statuses:         make(map[string]healthpb.HealthCheckResponse_ServingStatus),
+		stop:             make(chan struct{}),
+		done:             make(chan struct{}),
+	}
+}
+
+// Check returns the serving status of the requested service
+func (s *HealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.logger.Debug().Str("service", req.GetService()).Msg("gRPC health check called")
+
+	servingStatus, err := s.servingStatus(ctx, req.GetService())
+	if err != nil {
+		s.logger.Error().Err(err).Str("service", req.GetService()).Msg("Failed to resolve gRPC health status")
+		return nil, status.Error(codes.Internal, "failed to check health")
+	}
+	if servingStatus == healthpb.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
+	return &healthpb.HealthCheckResponse{Status: servingStatus}, nil
+}
+
+// List returns the serving status of every known service
+func (s *HealthServer) List(ctx context.Context, req *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	s.logger.Debug().Msg("gRPC health list called")
+
+	services := []string{"", ServiceLiveness, ServiceReadiness}
+	health, err := s.healthService.GetHealthStatus(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get health status for gRPC health list")
+		return nil, status.Error(codes.Internal, "failed to check health")
+	}
+	for name := range health.Checks {
+		services = append(services, name)
+	}
+
+	statuses := make(map[string]*healthpb.HealthCheckResponse, len(services))
+	for _, service := range services {
+		servingStatus, err := s.servingStatus(ctx, service)
+		if err != nil {
+			s.logger.Error().Err(err).Str("service", service).Msg("Failed to resolve gRPC health status")
+			return nil, status.Error(codes.Internal, "failed to check health")
+		}
+		statuses[service] = &healthpb.HealthCheckResponse{Status: servingStatus}
+	}
+
+	return &healthpb.HealthListResponse{Statuses: statuses}, nil
+}
+
+// Watch streams the serving status of the requested service whenever it changes.
+// Streams share the evaluation loop started by Start and are closed by Stop.
+func (s *HealthServer) Watch(req *healthpb.HealthCheckRequest, stream grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	service := req.GetService()
+	ctx := stream.Context()
+
+	w, err := s.subscribe(ctx, service)
+	if err != nil {
+		return err
+	}
+	defer s.unsubscribe(w)
+	s.logger.Debug().Str("service", service).Msg("gRPC health watch started")
+
+	for {
+		select {
+		case servingStatus := <-w.updates:
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+				s.logger.Debug().Err(err).Str("service", service).Msg("gRPC health watch stream closed")
+				return err
+			}
+		case <-s.stop:
+			// Deliver the final NOT_SERVING pushed by Stop, then close the stream, open
+			// streams would keep GracefulStop waiting until the shutdown timeout
+			select {
+			case servingStatus := <-w.updates:
+				if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+					return err
+				}
+			default:
+			}
+			s.logger.Debug().Str("service", service).Msg("gRPC health watch closed for draining")
+			return status.Error(codes.Unavailable, "server is draining")
+		case <-ctx.Done():
+			s.logger.Debug().Str("service", service).Msg("gRPC health watch ended")
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// Start starts re-evaluating the statuses of watched services every watch interval
+func (s *HealthServer) Start() {
+	s.startOnce.Do(func() {
+		s.logger.Info().Dur("interval_ms", s.config.WatchInterval).Msg("Starting gRPC health watch loop")
+		s.started.Store(true)
+		go s.run()
+	})
+}
+
+// Stop sends NOT_SERVING to every watcher, closes the Watch streams, rejects new
+// ones and stops the evaluation loop. It is called at the end of the drain period,
+// before the gRPC server stops.
+func (s *HealthServer) Stop() {
+	s.stopOnce.Do(func() {
+		s.logger.Info().Msg("Stopping gRPC health watch loop")
+		s.mu.Lock()
+		for service, watchers := range s.watchers {
+			if s.statuses[service] == healthpb.HealthCheckResponse_NOT_SERVING {
+				continue
+			}
+			s.statuses[service] = healthpb.HealthCheckResponse_NOT_SERVING
+			for w := range watchers {
+				w.push(healthpb.HealthCheckResponse_NOT_SERVING)
+			}
+		}
+		close(s.stop)
+		s.mu.Unlock()
+	})
+	if s.started.Load() {
+		<-s.done
+	}
+}
+
+// run re-evaluates the watched services until the server is stopped
+func (s *HealthServer) run() {
+	defer close(s.done)
+
+	heartbeat, err := s.watchdog.Register(watchLoopComponent, s.config.HeartbeatTimeout)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to register gRPC health watch heartbeat")
+	} else {
+		defer heartbeat.Stop()
+	}
+
+	ticker := time.NewTicker(s.config.WatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.Refresh()
+			if heartbeat != nil {
+				heartbeat.Beat()
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// Refresh evaluates every watched service once and notifies the watchers of the
+// services whose status changed, it runs every watch interval once started
+func (s *HealthServer) Refresh() {
+	s.mu.Lock()
+	services := make([]string, 0, len(s.watchers))
+	for service := range s.watchers {
+		services = append(services, service)
+	}
+	s.mu.Unlock()
+
+	for _, service := range services {
+		servingStatus := s.evaluate(context.Background(), service)
+
+		s.mu.Lock()
+		if _, watched := s.watchers[service]; watched && s.statuses[service] != servingStatus {
+			s.logger.Info().Str("service", service).Str("status", servingStatus.String()).Msg("gRPC health status changed")
+			s.statuses[service] = servingStatus
+			for w := range s.watchers[service] {
+				w.push(servingStatus)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// subscribe registers a watcher of service holding its current status, which is
+// only evaluated when no other stream watches the service already
+func (s *HealthServer) subscribe(ctx context.Context, service string) (*watcher, error) {
+	select {
+	case <-s.stop:
+		return nil, status.Error(codes.Unavailable, "server is draining")
+	default:
+	}
+
+	s.mu.Lock()
+	servingStatus, known := s.statuses[service]
+	s.mu.Unlock()
+	if !known {
+		servingStatus = s.evaluate(ctx, service)
+	}
+
+	w := &watcher{service: service, updates: make(chan healthpb.HealthCheckResponse_ServingStatus, 1)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.statuses[service]; ok {
+		// Another stream evaluated the service meanwhile
+		servingStatus = current
+	}
+	s.statuses[service] = servingStatus
+	if s.watchers[service] == nil {
+		s.watchers[service] = make(map[*watcher]struct{})
+	}
+	s.watchers[service][w] = struct{}{}
+	w.push(servingStatus)
+	return w, nil
+}
+
+// unsubscribe removes a watcher, forgetting the status of services nobody watches
+func (s *HealthServer) unsubscribe(w *watcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.watchers[w.service], w)
+	if len(s.watchers[w.service]) == 0 {
+		delete(s.watchers, w.service)
+		delete(s.statuses, w.service)
+	}
+}
+
+// evaluate resolves the serving status of a watched service, reporting failures as not serving
+func (s *HealthServer) evaluate(ctx context.Context, service string) healthpb.HealthCheckResponse_ServingStatus {
+	servingStatus, err := s.servingStatus(ctx, service)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("service", service).Msg("Failed to resolve gRPC health status for watch")
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return servingStatus
+}
+
+// watcher is a Watch stream of one service, only the latest unsent status is kept
+type watcher struct {
+	service string
+	updates chan healthpb.HealthCheckResponse_ServingStatus
+}
+
+// push replaces the pending status, it is called with the server lock held
+func (w *watcher) push(servingStatus healthpb.HealthCheckResponse_ServingStatus) {
+	select {
+	case <-w.updates:
+	default:
+	}
+	w.updates <- servingStatus
+}
+
+// servingStatus resolves the serving status of a service name. The overall and
+// readiness services follow readiness, liveness follows liveness and any other
+// name is looked up among the registered dependency checks.
+func (s *HealthServer) servingStatus(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	switch service {
+	case "", ServiceReadiness:
+		readiness, err := s.readinessService.GetReadinessStatus(ctx)
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN, err
+		}
+		return toServingStatus(readiness.IsReady()), nil
+	case ServiceLiveness:
+		liveness, err := s.livenessService.GetLivenessStatus(ctx)
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN, err
+		}
+		return toServingStatus(liveness.IsAlive()), nil
+	default:
+		health, err := s.healthService.GetHealthStatus(ctx)
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN, err
+		}
+		check, ok := health.Checks[service]
+		if !ok {
+			return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, nil
+		}
+		return toServingStatus(check.IsUp()), nil
+	}
+}
+
+// toServingStatus maps a boolean status to a gRPC serving status
+func toServingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// RegisterServices registers the health service with the gRPC server
+func (s *HealthServer) RegisterServices(server *grpc.Server) {
+	s.logger.Info().Msg("Registering gRPC health service")
+	healthpb.RegisterHealthServer(server, s)
+	s.logger.Debug().Str("service", healthpb.Health_ServiceDesc.ServiceName).Msg("gRPC health service registered")
+}
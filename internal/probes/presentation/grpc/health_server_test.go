@@ -0,0 +1,297 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-clean/internal/probes/application/query"
+	"github.com/go-clean/internal/probes/domain"
+	"github.com/go-clean/internal/probes/ports"
+	"github.com/go-clean/platform/logger/logtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// fakeChecker is a health checker failing while down is set
+type fakeChecker struct {
+	name string
+	down atomic.Bool
+}
+
+func (c *fakeChecker) Name() string           { return c.name }
+func (c *fakeChecker) Critical() bool         { return false }
+func (c *fakeChecker) Timeout() time.Duration { return 0 }
+
+func (c *fakeChecker) Check(context.Context) error {
+	if c.down.Load() {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+// nopMetrics discards the recorded health results
+type nopMetrics struct{}
+
+func (nopMetrics) RecordHealth(*domain.HealthResponse) {}
+
+// fakeWatchdog hands out a heartbeat counting its beats and never reports stalls
+type fakeWatchdog struct {
+	heartbeat fakeHeartbeat
+}
+
+func (w *fakeWatchdog) Register(string, time.Duration) (ports.Heartbeat, error) {
+	return &w.heartbeat, nil
+}
+
+func (w *fakeWatchdog) Stalled(time.Time) []domain.LivenessFailure { return nil }
+
+type fakeHeartbeat struct {
+	beats   atomic.Int32
+	stopped atomic.Bool
+}
+
+func (h *fakeHeartbeat) Beat() { h.beats.Add(1) }
+func (h *fakeHeartbeat) Stop() { h.stopped.Store(true) }
+
+// fakeStream is a Watch stream recording the sent statuses
+type fakeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan healthpb.HealthCheckResponse_ServingStatus
+}
+
+func newFakeStream(ctx context.Context) *fakeStream {
+	return &fakeStream{ctx: ctx, sent: make(chan healthpb.HealthCheckResponse_ServingStatus, 16)}
+}
+
+func (s *fakeStream) Context() context.Context { return s.ctx }
+
+func (s *fakeStream) Send(response *healthpb.HealthCheckResponse) error {
+	s.sent <- response.GetStatus()
+	return nil
+}
+
+// next waits for the next sent status
+func (s *fakeStream) next(t *testing.T) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	select {
+	case servingStatus := <-s.sent:
+		return servingStatus
+	case <-time.After(time.Second):
+		t.Fatal("no status sent")
+		return healthpb.HealthCheckResponse_UNKNOWN
+	}
+}
+
+// assertNothingSent fails when a status was sent
+func (s *fakeStream) assertNothingSent(t *testing.T) {
+	t.Helper()
+	select {
+	case servingStatus := <-s.sent:
+		t.Fatalf("sent %s, want no update", servingStatus)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+// testServer is a health server backed by the real probe services
+type testServer struct {
+	*HealthServer
+	checker   *fakeChecker
+	lifecycle *domain.Lifecycle
+	watchdog  *fakeWatchdog
+}
+
+func newTestServer(t *testing.T, config HealthServerConfig) *testServer {
+	t.Helper()
+	log := logtest.Nop()
+	checker := &fakeChecker{name: "redis"}
+	registry := query.NewHealthCheckerRegistry(log)
+	if err := registry.Register(checker); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	handler := query.NewGetHealthQueryHandler(log, registry, query.HealthQueryConfig{Timeout: time.Second}, nopMetrics{})
+	healthService := query.NewHealthService(log, handler, query.NewHealthPoller(log, handler, query.HealthPollerConfig{}, nil))
+
+	watchdog := &fakeWatchdog{}
+	lifecycle := domain.NewLifecycle()
+	livenessService := query.NewLivenessService(log, query.NewGetLivenessQueryHandler(log, query.LivenessQueryConfig{}, watchdog))
+	readinessService := query.NewReadinessService(log, query.NewGetReadinessQueryHandler(log, lifecycle, healthService))
+
+	server := NewHealthServer(log, config, healthService, livenessService, readinessService, watchdog)
+	return &testServer{HealthServer: server, checker: checker, lifecycle: lifecycle, watchdog: watchdog}
+}
+
+// watch runs Watch in the background, returning its stream and result
+func (s *testServer) watch(ctx context.Context, service string) (*fakeStream, <-chan error) {
+	stream := newFakeStream(ctx)
+	result := make(chan error, 1)
+	go func() {
+		result <- s.Watch(&healthpb.HealthCheckRequest{Service: service}, stream)
+	}()
+	return stream, result
+}
+
+// waitResult waits for Watch to return
+func waitResult(t *testing.T, result <-chan error) error {
+	t.Helper()
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(time.Second):
+		t.Fatal("Watch did not return")
+		return nil
+	}
+}
+
+func TestHealthServerCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		service  string
+		ready    bool
+		down     bool
+		want     healthpb.HealthCheckResponse_ServingStatus
+		wantCode codes.Code
+	}{
+		{name: "overall while starting", service: "", want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "overall when ready", service: "", ready: true, want: healthpb.HealthCheckResponse_SERVING},
+		{name: "readiness when ready", service: ServiceReadiness, ready: true, want: healthpb.HealthCheckResponse_SERVING},
+		{name: "liveness while starting", service: ServiceLiveness, want: healthpb.HealthCheckResponse_SERVING},
+		{name: "dependency up", service: "redis", want: healthpb.HealthCheckResponse_SERVING},
+		{name: "dependency down", service: "redis", down: true, want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "unknown service", service: "kafka", wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, HealthServerConfig{})
+			if tt.ready {
+				if err := server.lifecycle.TransitionTo(domain.ReadinessStateReady); err != nil {
+					t.Fatalf("TransitionTo failed: %v", err)
+				}
+			}
+			server.checker.down.Store(tt.down)
+
+			response, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tt.service})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("code = %s, want %s", got, tt.wantCode)
+			}
+			if err == nil && response.GetStatus() != tt.want {
+				t.Errorf("status = %s, want %s", response.GetStatus(), tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthServerWatchSendsChanges(t *testing.T) {
+	server := newTestServer(t, HealthServerConfig{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, firstResult := server.watch(ctx, "redis")
+	if got := first.next(t); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("initial status = %s, want SERVING", got)
+	}
+	second, secondResult := server.watch(ctx, "redis")
+	if got := second.next(t); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("initial status of the second stream = %s, want SERVING", got)
+	}
+
+	// Unchanged statuses are not sent again
+	server.Refresh()
+	first.assertNothingSent(t)
+
+	// Every stream watching the service receives the change
+	server.checker.down.Store(true)
+	server.Refresh()
+	for i, stream := range []*fakeStream{first, second} {
+		if got := stream.next(t); got != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Errorf("stream %d status = %s, want NOT_SERVING", i, got)
+		}
+	}
+
+	cancel()
+	for _, result := range []<-chan error{firstResult, secondResult} {
+		if got := status.Code(waitResult(t, result)); got != codes.Canceled {
+			t.Errorf("code = %s, want Canceled", got)
+		}
+	}
+
+	// The status of services nobody watches is forgotten
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if len(server.watchers) != 0 || len(server.statuses) != 0 {
+		t.Errorf("watchers = %v, statuses = %v, want both empty", server.watchers, server.statuses)
+	}
+}
+
+func TestHealthServerWatchLoop(t *testing.T) {
+	server := newTestServer(t, HealthServerConfig{WatchInterval: 5 * time.Millisecond, HeartbeatTimeout: time.Second})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server.Start()
+	stream, result := server.watch(ctx, "redis")
+	if got := stream.next(t); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("initial status = %s, want SERVING", got)
+	}
+
+	// The loop picks up the change without an explicit refresh
+	server.checker.down.Store(true)
+	if got := stream.next(t); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("status = %s, want NOT_SERVING", got)
+	}
+
+	server.Stop()
+	if got := status.Code(waitResult(t, result)); got != codes.Unavailable {
+		t.Errorf("code = %s, want Unavailable", got)
+	}
+	heartbeat := &server.watchdog.heartbeat
+	if heartbeat.beats.Load() == 0 || !heartbeat.stopped.Load() {
+		t.Errorf("beats = %d, stopped = %t, want beats and the heartbeat stopped", heartbeat.beats.Load(), heartbeat.stopped.Load())
+	}
+}
+
+func TestHealthServerStopDrainsWatchers(t *testing.T) {
+	server := newTestServer(t, HealthServerConfig{})
+	if err := server.lifecycle.TransitionTo(domain.ReadinessStateReady); err != nil {
+		t.Fatalf("TransitionTo failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, result := server.watch(ctx, "")
+	if got := stream.next(t); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("initial status = %s, want SERVING", got)
+	}
+
+	// Stop may be called concurrently and more than once
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			server.Stop()
+		}()
+	}
+	wg.Wait()
+
+	// The watcher learns it is no longer served before the stream closes
+	if got := stream.next(t); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("final status = %s, want NOT_SERVING", got)
+	}
+	if got := status.Code(waitResult(t, result)); got != codes.Unavailable {
+		t.Errorf("code = %s, want Unavailable", got)
+	}
+
+	// New streams are rejected once stopped
+	_, result = server.watch(ctx, "")
+	if got := status.Code(waitResult(t, result)); got != codes.Unavailable {
+		t.Errorf("code after Stop = %s, want Unavailable", got)
+	}
+}
@@ -1,26 +1,52 @@
 package http
 
 import (
+	"crypto/subtle"
 	"net/http"
+	"strings"
 
 	"github.com/go-clean/internal/probes/application/query"
+	"github.com/go-clean/internal/probes/domain"
 	"github.com/go-clean/platform/logger"
 	"github.com/gofiber/fiber/v2"
 )
 
+// HealthHandlerConfig holds configuration for the health handler
+type HealthHandlerConfig struct {
+	// Identity identifies the service in application/health+json responses
+	Identity domain.ServiceIdentity
+	// VerboseToken is the bearer token that authenticates callers for health diagnostics
+	VerboseToken string
+	// AllowAnonymousVerbose allows ?verbose=true without authentication (development only)
+	AllowAnonymousVerbose bool
+}
+
 // HealthHandler handles health check HTTP requests
 type HealthHandler struct {
-	logger          logger.Logger
-	healthService   *query.HealthService
-	livenessService *query.LivenessService
+	logger           logger.Logger
+	config           HealthHandlerConfig
+	healthService    *query.HealthService
+	livenessService  *query.LivenessService
+	readinessService *query.ReadinessService
+	startupService   *query.StartupService
 }
 
 // NewHealthHandler creates a new health handler
-func NewHealthHandler(logger logger.Logger, healthService *query.HealthService, livenessService *query.LivenessService) *HealthHandler {
+func NewHealthHandler(
+	logger logger.Logger,
+	config HealthHandlerConfig,
+	healthService *query.HealthService,
+	livenessService *query.LivenessService,
+	readinessService *query.ReadinessService,
+	startupService *query.StartupService,
+) *HealthHandler {
 	return &HealthHandler{
-		logger:          logger,
-		healthService:   healthService,
-		livenessService: livenessService,
+		logger:           logger,
+		config:           config,
+		healthService:    healthService,
+		livenessService:  livenessService,
+		readinessService: readinessService,
+		startupService:   startupService,
 	}
 }
 
@@ -30,36 +56,83 @@ func NewHealthHandler(logger logger.Logger, healthService *query.HealthService,
 // @Tags Health
 // @Accept json
 // @Produce json
-// @Success 200 {object} domain.HealthResponse "System is healthy"
+// @Produce application/health+json
+// @Param fresh query bool false "Force a live check instead of serving the cached result"
+// @Param verbose query bool false "Include check diagnostics (requires authentication unless running in debug mode)"
+// @Security BearerAuth
+// @Success 200 {object} domain.HealthResponse "System is healthy or degraded"
 // @Success 503 {object} domain.HealthResponse "System is unhealthy"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /health [get]
 func (h *HealthHandler) GetHealth(c *fiber.Ctx) error {
-	h.logger.Info().Str("endpoint", "/health").Msg("Health check endpoint called")
-	ctx := c.Context()
+	ctx := c.UserContext()
+	log := h.logger.FromContext(ctx)
+	log.Info().Str("endpoint", "/health").Msg("Health check endpoint called")
 
-	// Get health status from service
-	healthResponse, err := h.healthService.GetHealthStatus(ctx)
+	// Get health status from service, bypassing the cache when a fresh result is
+	// requested; dependency details are only collected for verbose callers
+	verbose := h.isVerbose(c)
+	getHealthStatus := h.healthService.GetHealthStatus
+	switch {
+	case verbose:
+		getHealthStatus = h.healthService.GetVerboseHealthStatus
+	case c.QueryBool("fresh"):
+		getHealthStatus = h.healthService.GetFreshHealthStatus
+	}
+	healthResponse, err := getHealthStatus(ctx)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to get health status from service")
+		log.Error().Err(err).Msg("Failed to get health status from service")
 		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "Failed to check system health",
 			"details": err.Error(),
 		})
 	}
 
-	// Return appropriate HTTP status based on health
+	// Return appropriate HTTP status based on health, only critical failures yield 503
 	statusCode := http.StatusOK
-	if !healthResponse.IsHealthy() {
+	if !healthResponse.IsAvailable() {
 		statusCode = http.StatusServiceUnavailable
-		h.logger.Warn().Int("status_code", statusCode).Bool("is_healthy", false).Msg("System is unhealthy")
+		log.Warn().Int("status_code", statusCode).Str("status", string(healthResponse.Status)).Msg("System is unhealthy")
+	} else if !healthResponse.IsHealthy() {
+		log.Warn().Int("status_code", statusCode).Str("status", string(healthResponse.Status)).Msg("System is degraded")
 	} else {
-		h.logger.Info().Int("status_code", statusCode).Bool("is_healthy", true).Msg("System is healthy")
+		log.Info().Int("status_code", statusCode).Str("status", string(healthResponse.Status)).Msg("System is healthy")
+	}
+
+	// Diagnostics are only exposed to verbose or authenticated callers
+	if !verbose {
+		healthResponse = healthResponse.WithoutDiagnostics()
+	}
+
+	// Negotiate between the default format and the health+json draft format
+	if c.Accepts(fiber.MIMEApplicationJSON, domain.HealthJSONMediaType) == domain.HealthJSONMediaType {
+		return c.Status(statusCode).JSON(domain.NewHealthJSONResponse(healthResponse, h.config.Identity), domain.HealthJSONMediaType)
 	}
 
 	return c.Status(statusCode).JSON(healthResponse)
 }
 
+// isVerbose reports whether the caller may see health check diagnostics
+func (h *HealthHandler) isVerbose(c *fiber.Ctx) bool {
+	if h.isAuthenticated(c) {
+		return true
+	}
+	return h.config.AllowAnonymousVerbose && c.QueryBool("verbose")
+}
+
+// isAuthenticated reports whether the request carries the configured verbose bearer token
+func (h *HealthHandler) isAuthenticated(c *fiber.Ctx) bool {
+	if h.config.VerboseToken == "" {
+		return false
+	}
+
+	token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.config.VerboseToken)) == 1
+}
+
 // GetLiveness handles GET /liveness requests
 // @Summary Get liveness status
 // @Description Returns the liveness status of the service for Kubernetes liveness probes
@@ -71,13 +144,14 @@ func (h *HealthHandler) GetHealth(c *fiber.Ctx) error {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /liveness [get]
 func (h *HealthHandler) GetLiveness(c *fiber.Ctx) error {
-	h.logger.Info().Str("endpoint", "/liveness").Msg("Liveness check endpoint called")
-	ctx := c.Context()
+	ctx := c.UserContext()
+	log := h.logger.FromContext(ctx)
+	log.Info().Str("endpoint", "/liveness").Msg("Liveness check endpoint called")
 
 	// Get liveness status from service
 	livenessResponse, err := h.livenessService.GetLivenessStatus(ctx)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to get liveness status from service")
+		log.Error().Err(err).Msg("Failed to get liveness status from service")
 		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "Failed to check service liveness",
 			"details": err.Error(),
@@ -88,19 +162,97 @@ func (h *HealthHandler) GetLiveness(c *fiber.Ctx) error {
 	statusCode := http.StatusOK
 	if !livenessResponse.IsAlive() {
 		statusCode = http.StatusServiceUnavailable
-		h.logger.Warn().Int("status_code", statusCode).Bool("is_alive", false).Msg("Service is not alive")
+		log.Warn().Int("status_code", statusCode).Bool("is_alive", false).Msg("Service is not alive")
 	} else {
-		h.logger.Info().Int("status_code", statusCode).Bool("is_alive", true).Int64("uptime_seconds", livenessResponse.UptimeSeconds).Msg("Service is alive")
+		log.Info().Int("status_code", statusCode).Bool("is_alive", true).Int64("uptime_seconds", livenessResponse.UptimeSeconds).Msg("Service is alive")
 	}
 
 	return c.Status(statusCode).JSON(livenessResponse)
 }
 
+// GetReadiness handles GET /readyz requests
+// @Summary Get readiness status
+// @Description Returns whether the service should receive traffic, for Kubernetes readiness probes
+// @Tags Health
+// @Accept json
+// @Produce json
+// @Success 200 {object} domain.ReadinessResponse "Service is ready"
+// @Success 503 {object} domain.ReadinessResponse "Service is starting, draining or a critical dependency is down"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /readyz [get]
+func (h *HealthHandler) GetReadiness(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	log := h.logger.FromContext(ctx)
+	log.Info().Str("endpoint", "/readyz").Msg("Readiness check endpoint called")
+
+	// Get readiness status from service
+	readinessResponse, err := h.readinessService.GetReadinessStatus(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get readiness status from service")
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Failed to check service readiness",
+			"details": err.Error(),
+		})
+	}
+
+	// Return appropriate HTTP status based on readiness
+	statusCode := http.StatusOK
+	if !readinessResponse.IsReady() {
+		statusCode = http.StatusServiceUnavailable
+		log.Warn().Int("status_code", statusCode).Str("status", string(readinessResponse.Status)).Msg("Service is not ready")
+	} else {
+		log.Info().Int("status_code", statusCode).Str("status", string(readinessResponse.Status)).Msg("Service is ready")
+	}
+
+	return c.Status(statusCode).JSON(readinessResponse)
+}
+
+// GetStartup handles GET /startupz requests
+// @Summary Get startup status
+// @Description Returns whether the service has completed startup, for Kubernetes startup probes
+// @Tags Health
+// @Accept json
+// @Produce json
+// @Success 200 {object} domain.StartupResponse "Service has started"
+// @Success 503 {object} domain.StartupResponse "Service is still starting"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /startupz [get]
+func (h *HealthHandler) GetStartup(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	log := h.logger.FromContext(ctx)
+	log.Info().Str("endpoint", "/startupz").Msg("Startup check endpoint called")
+
+	// Get startup status from service
+	startupResponse, err := h.startupService.GetStartupStatus(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get startup status from service")
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Failed to check service startup",
+			"details": err.Error(),
+		})
+	}
+
+	// Return appropriate HTTP status based on startup
+	statusCode := http.StatusOK
+	if !startupResponse.IsStarted() {
+		statusCode = http.StatusServiceUnavailable
+		log.Warn().Int("status_code", statusCode).Bool("is_started", false).Msg("Service is still starting")
+	} else {
+		log.Info().Int("status_code", statusCode).Bool("is_started", true).Msg("Service has started")
+	}
+
+	return c.Status(statusCode).JSON(startupResponse)
+}
+
 // RegisterRoutes registers health-related routes
 func (h *HealthHandler) RegisterRoutes(router fiber.Router) {
 	h.logger.Info().Msg("Registering health routes")
 	router.Get("/health", h.GetHealth)
 	router.Get("/liveness", h.GetLiveness)
+	router.Get("/readyz", h.GetReadiness)
+	router.Get("/startupz", h.GetStartup)
 	h.logger.Debug().Str("route", "/health").Msg("Health route registered")
 	h.logger.Debug().Str("route", "/liveness").Msg("Liveness route registered")
+	h.logger.Debug().Str("route", "/readyz").Msg("Readiness route registered")
+	h.logger.Debug().Str("route", "/startupz").Msg("Startup route registered")
 }
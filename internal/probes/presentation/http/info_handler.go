@@ -0,0 +1,57 @@
+package http
+
+import (
+	"github.com/go-clean/internal/probes/application/query"
+	"github.com/go-clean/platform/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// InfoHandler handles HTTP requests for build and runtime info endpoints
+type InfoHandler struct {
+	logger      logger.Logger
+	infoService *query.InfoService
+}
+
+// NewInfoHandler creates a new info HTTP handler
+func NewInfoHandler(logger logger.Logger, infoService *query.InfoService) *InfoHandler {
+	return &InfoHandler{
+		logger:      logger,
+		infoService: infoService,
+	}
+}
+
+// GetInfo handles GET /info and GET /version requests
+// @Summary Get build and runtime information
+// @Description Returns the application name, version, environment, VCS revision, Go version, dependency versions and start time
+// @Tags Health
+// @Accept json
+// @Produce json
+// @Success 200 {object} domain.InfoResponse
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /info [get]
+func (h *InfoHandler) GetInfo(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	log := h.logger.FromContext(ctx)
+	log.Info().Str("endpoint", c.Path()).Msg("Info endpoint called")
+
+	response, err := h.infoService.GetInfo(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get info from service")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Failed to get service info",
+			"details": err.Error(),
+		})
+	}
+
+	log.Debug().Str("version", response.Version).Msg("Info request handled successfully")
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// RegisterRoutes registers info routes
+func (h *InfoHandler) RegisterRoutes(router fiber.Router) {
+	h.logger.Info().Msg("Registering info routes")
+	router.Get("/info", h.GetInfo)
+	router.Get("/version", h.GetInfo)
+	h.logger.Debug().Str("route", "/info").Msg("Info route registered")
+	h.logger.Debug().Str("route", "/version").Msg("Version route registered")
+}
@@ -29,18 +29,19 @@ func NewPingHandler(logger logger.Logger, pingQueryHandler *query.PingQueryHandl
 // @Success 200 {object} domain.PingResponse
 // @Router /ping [get]
 func (h *PingHandler) Ping(c *fiber.Ctx) error {
-	h.logger.Info().Str("endpoint", "/ping").Msg("Ping endpoint called")
-	ctx := c.Context()
+	ctx := c.UserContext()
+	log := h.logger.FromContext(ctx)
+	log.Info().Str("endpoint", "/ping").Msg("Ping endpoint called")
 
 	response, err := h.pingQueryHandler.Handle(ctx)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to handle ping request")
+		log.Error().Err(err).Msg("Failed to handle ping request")
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Internal server error",
 		})
 	}
 
-	h.logger.Debug().Msg("Ping request handled successfully")
+	log.Debug().Msg("Ping request handled successfully")
 	return c.Status(fiber.StatusOK).JSON(response)
 }
 
@@ -1,60 +1,238 @@
 package probes
 
 import (
+	lifecycleCommand "github.com/go-clean/internal/probes/application/command"
 	healthQuery "github.com/go-clean/internal/probes/application/query"
 	pingQuery "github.com/go-clean/internal/probes/application/query"
+	"github.com/go-clean/internal/probes/domain"
 	healthInfra "github.com/go-clean/internal/probes/infrastructure"
+	"github.com/go-clean/internal/probes/ports"
+	healthGrpc "github.com/go-clean/internal/probes/presentation/grpc"
 	healthHttp "github.com/go-clean/internal/probes/presentation/http"
 	pingHttp "github.com/go-clean/internal/probes/presentation/http"
+	"github.com/go-clean/platform/config"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/metrics"
+	"github.com/go-clean/platform/watchdog"
 	"github.com/google/wire"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 )
 
+// loggerName is the name of the logger used by the probes module
+const loggerName = "probes"
+
 // ProvidePingQueryHandler provides a ping query handler
 func ProvidePingQueryHandler(logger logger.Logger) *pingQuery.PingQueryHandler {
-	return pingQuery.NewPingQueryHandler(logger)
+	return pingQuery.NewPingQueryHandler(logger.Named(loggerName))
 }
 
 // ProvidePingHandler provides a ping HTTP handler
 func ProvidePingHandler(logger logger.Logger, pingQueryHandler *pingQuery.PingQueryHandler) *pingHttp.PingHandler {
-	return pingHttp.NewPingHandler(logger, pingQueryHandler)
+	return pingHttp.NewPingHandler(logger.Named(loggerName), pingQueryHandler)
 }
 
 // ProvideDatabaseChecker provides a database checker
-func ProvideDatabaseChecker(logger logger.Logger, db *pgxpool.Pool) *healthInfra.DatabaseChecker {
-	return healthInfra.NewDatabaseChecker(logger, db)
+func ProvideDatabaseChecker(logger logger.Logger, db *pgxpool.Pool, cfg *config.Config) *healthInfra.DatabaseChecker {
+	return healthInfra.NewDatabaseChecker(logger.Named(loggerName), db, cfg.Health.DatabaseTimeout, cfg.Health.DatabaseCritical)
 }
 
 // ProvideRedisChecker provides a Redis checker
-func ProvideRedisChecker(logger logger.Logger, redisClient *redis.Client) *healthInfra.RedisChecker {
-	return healthInfra.NewRedisChecker(logger, redisClient)
+func ProvideRedisChecker(logger logger.Logger, redisClient *redis.Client, cfg *config.Config) *healthInfra.RedisChecker {
+	return healthInfra.NewRedisChecker(logger.Named(loggerName), redisClient, cfg.Health.RedisTimeout, cfg.Health.RedisCritical)
+}
+
+// HealthCheckers are the health checkers the probes module contributes. Every
+// module reporting dependencies on /health provides its own slice type, which
+// the application aggregates into the registry.
+type HealthCheckers []ports.HealthChecker
+
+// ProvideHealthCheckers provides the database and Redis health checkers
+func ProvideHealthCheckers(databaseChecker *healthInfra.DatabaseChecker, redisChecker *healthInfra.RedisChecker) HealthCheckers {
+	return HealthCheckers{databaseChecker, redisChecker}
+}
+
+// ProvideHealthCheckerRegistry provides a health checker registry populated with every contributed checker
+func ProvideHealthCheckerRegistry(logger logger.Logger, checkers []ports.HealthChecker) (*healthQuery.HealthCheckerRegistry, error) {
+	registry := healthQuery.NewHealthCheckerRegistry(logger.Named(loggerName))
+	if err := registry.Register(checkers...); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// ProvideHealthQueryConfig provides the health query configuration
+func ProvideHealthQueryConfig(cfg *config.Config) healthQuery.HealthQueryConfig {
+	return healthQuery.HealthQueryConfig{
+		Timeout: cfg.Health.Timeout,
+	}
+}
+
+// ProvideHealthMetricsRecorder provides the health metrics recorder implementation
+func ProvideHealthMetricsRecorder(registry *metrics.Registry) (ports.HealthMetricsRecorder, error) {
+	return healthInfra.NewPrometheusHealthMetrics(registry)
 }
 
 // ProvideHealthQueryHandler provides a health query handler
-func ProvideHealthQueryHandler(logger logger.Logger, databaseChecker *healthInfra.DatabaseChecker, redisChecker *healthInfra.RedisChecker) *healthQuery.GetHealthQueryHandler {
-	return healthQuery.NewGetHealthQueryHandler(logger, databaseChecker, redisChecker)
+func ProvideHealthQueryHandler(logger logger.Logger, registry *healthQuery.HealthCheckerRegistry, queryConfig healthQuery.HealthQueryConfig, metricsRecorder ports.HealthMetricsRecorder) *healthQuery.GetHealthQueryHandler {
+	return healthQuery.NewGetHealthQueryHandler(logger.Named(loggerName), registry, queryConfig, metricsRecorder)
+}
+
+// ProvideHealthPollerConfig provides the background health refresh configuration
+func ProvideHealthPollerConfig(cfg *config.Config) healthQuery.HealthPollerConfig {
+	return healthQuery.HealthPollerConfig{
+		Enabled:  cfg.Health.BackgroundRefresh,
+		Interval: cfg.Health.RefreshInterval,
+		MaxAge:   cfg.Health.CacheMaxAge,
+		// A refresh is late once it misses two ticks plus the time a slow check run may take
+		HeartbeatTimeout: 2*cfg.Health.RefreshInterval + cfg.Health.Timeout,
+	}
+}
+
+// ProvideHealthPoller provides a background health poller
+func ProvideHealthPoller(logger logger.Logger, healthQueryHandler *healthQuery.GetHealthQueryHandler, pollerConfig healthQuery.HealthPollerConfig, watchdog ports.Watchdog) *healthQuery.HealthPoller {
+	return healthQuery.NewHealthPoller(logger.Named(loggerName), healthQueryHandler, pollerConfig, watchdog)
 }
 
 // ProvideHealthService provides a health service
-func ProvideHealthService(logger logger.Logger, healthQueryHandler *healthQuery.GetHealthQueryHandler) *healthQuery.HealthService {
-	return healthQuery.NewHealthService(logger, healthQueryHandler)
+func ProvideHealthService(logger logger.Logger, healthQueryHandler *healthQuery.GetHealthQueryHandler, healthPoller *healthQuery.HealthPoller) *healthQuery.HealthService {
+	return healthQuery.NewHealthService(logger.Named(loggerName), healthQueryHandler, healthPoller)
+}
+
+// ProvideWatchdog provides the watchdog port implementation
+func ProvideWatchdog(w *watchdog.Watchdog) ports.Watchdog {
+	return healthInfra.NewWatchdogAdapter(w)
+}
+
+// ProvideLivenessQueryConfig provides the liveness query configuration
+func ProvideLivenessQueryConfig(cfg *config.Config) healthQuery.LivenessQueryConfig {
+	return healthQuery.LivenessQueryConfig{
+		MaxGoroutines: cfg.Health.MaxGoroutines,
+	}
 }
 
 // ProvideLivenessQueryHandler provides a liveness query handler
-func ProvideLivenessQueryHandler(logger logger.Logger) *healthQuery.GetLivenessQueryHandler {
-	return healthQuery.NewGetLivenessQueryHandler(logger)
+func ProvideLivenessQueryHandler(logger logger.Logger, queryConfig healthQuery.LivenessQueryConfig, watchdog ports.Watchdog) *healthQuery.GetLivenessQueryHandler {
+	return healthQuery.NewGetLivenessQueryHandler(logger.Named(loggerName), queryConfig, watchdog)
 }
 
 // ProvideLivenessService provides a liveness service
 func ProvideLivenessService(logger logger.Logger, livenessQueryHandler *healthQuery.GetLivenessQueryHandler) *healthQuery.LivenessService {
-	return healthQuery.NewLivenessService(logger, livenessQueryHandler)
+	return healthQuery.NewLivenessService(logger.Named(loggerName), livenessQueryHandler)
+}
+
+// ProvideLifecycle provides the readiness state machine shared by the probes module
+func ProvideLifecycle() *domain.Lifecycle {
+	return domain.NewLifecycle()
+}
+
+// ProvideMarkReadyCommandHandler provides a mark ready command handler
+func ProvideMarkReadyCommandHandler(logger logger.Logger, lifecycle *domain.Lifecycle) *lifecycleCommand.MarkReadyCommandHandler {
+	return lifecycleCommand.NewMarkReadyCommandHandler(logger.Named(loggerName), lifecycle)
+}
+
+// ProvideStartDrainingCommandHandler provides a start draining command handler
+func ProvideStartDrainingCommandHandler(logger logger.Logger, lifecycle *domain.Lifecycle) *lifecycleCommand.StartDrainingCommandHandler {
+	return lifecycleCommand.NewStartDrainingCommandHandler(logger.Named(loggerName), lifecycle)
+}
+
+// ProvideLifecycleService provides a lifecycle service
+func ProvideLifecycleService(logger logger.Logger, markReadyHandler *lifecycleCommand.MarkReadyCommandHandler, startDrainingHandler *lifecycleCommand.StartDrainingCommandHandler) *lifecycleCommand.LifecycleService {
+	return lifecycleCommand.NewLifecycleService(logger.Named(loggerName), markReadyHandler, startDrainingHandler)
+}
+
+// ProvideReadinessQueryHandler provides a readiness query handler
+func ProvideReadinessQueryHandler(logger logger.Logger, lifecycle *domain.Lifecycle, healthService *healthQuery.HealthService) *healthQuery.GetReadinessQueryHandler {
+	return healthQuery.NewGetReadinessQueryHandler(logger.Named(loggerName), lifecycle, healthService)
+}
+
+// ProvideReadinessService provides a readiness service
+func ProvideReadinessService(logger logger.Logger, readinessQueryHandler *healthQuery.GetReadinessQueryHandler) *healthQuery.ReadinessService {
+	return healthQuery.NewReadinessService(logger.Named(loggerName), readinessQueryHandler)
+}
+
+// ProvideStartupQueryHandler provides a startup query handler
+func ProvideStartupQueryHandler(logger logger.Logger, lifecycle *domain.Lifecycle) *healthQuery.GetStartupQueryHandler {
+	return healthQuery.NewGetStartupQueryHandler(logger.Named(loggerName), lifecycle)
+}
+
+// ProvideStartupService provides a startup service
+func ProvideStartupService(logger logger.Logger, startupQueryHandler *healthQuery.GetStartupQueryHandler) *healthQuery.StartupService {
+	return healthQuery.NewStartupService(logger.Named(loggerName), startupQueryHandler)
+}
+
+// ProvideHealthHandlerConfig provides the health HTTP handler configuration
+func ProvideHealthHandlerConfig(cfg *config.Config) healthHttp.HealthHandlerConfig {
+	return healthHttp.HealthHandlerConfig{
+		Identity: domain.ServiceIdentity{
+			ServiceID: cfg.App.Name,
+			ReleaseID: cfg.App.Version,
+		},
+		VerboseToken:          cfg.Health.VerboseToken,
+		AllowAnonymousVerbose: cfg.App.Debug,
+	}
 }
 
 // ProvideHealthHandler provides a health HTTP handler
-func ProvideHealthHandler(logger logger.Logger, healthService *healthQuery.HealthService, livenessService *healthQuery.LivenessService) *healthHttp.HealthHandler {
-	return healthHttp.NewHealthHandler(logger, healthService, livenessService)
+func ProvideHealthHandler(
+	logger logger.Logger,
+	handlerConfig healthHttp.HealthHandlerConfig,
+	healthService *healthQuery.HealthService,
+	livenessService *healthQuery.LivenessService,
+	readinessService *healthQuery.ReadinessService,
+	startupService *healthQuery.StartupService,
+) *healthHttp.HealthHandler {
+	return healthHttp.NewHealthHandler(logger.Named(loggerName), handlerConfig, healthService, livenessService, readinessService, startupService)
+}
+
+// ProvideBuildInfoProvider provides the build info provider implementation
+func ProvideBuildInfoProvider() ports.BuildInfoProvider {
+	return healthInfra.NewBuildInfoProvider()
+}
+
+// ProvideInfoQueryConfig provides the info query configuration
+func ProvideInfoQueryConfig(cfg *config.Config) healthQuery.InfoQueryConfig {
+	return healthQuery.InfoQueryConfig{
+		Name:        cfg.App.Name,
+		Version:     cfg.App.Version,
+		Environment: cfg.App.Environment,
+	}
+}
+
+// ProvideInfoQueryHandler provides an info query handler
+func ProvideInfoQueryHandler(logger logger.Logger, queryConfig healthQuery.InfoQueryConfig, buildInfoProvider ports.BuildInfoProvider) *healthQuery.GetInfoQueryHandler {
+	return healthQuery.NewGetInfoQueryHandler(logger.Named(loggerName), queryConfig, buildInfoProvider)
+}
+
+// ProvideInfoService provides an info service
+func ProvideInfoService(logger logger.Logger, queryHandler *healthQuery.GetInfoQueryHandler) *healthQuery.InfoService {
+	return healthQuery.NewInfoService(logger.Named(loggerName), queryHandler)
+}
+
+// ProvideInfoHandler provides an info HTTP handler
+func ProvideInfoHandler(logger logger.Logger, infoService *healthQuery.InfoService) *healthHttp.InfoHandler {
+	return healthHttp.NewInfoHandler(logger.Named(loggerName), infoService)
+}
+
+// ProvideHealthServerConfig provides the gRPC health server configuration
+func ProvideHealthServerConfig(cfg *config.Config) healthGrpc.HealthServerConfig {
+	return healthGrpc.HealthServerConfig{
+		WatchInterval: cfg.GRPC.HealthWatchInterval,
+		// A refresh evaluates each watched service, every evaluation bounded by the health timeout
+		HeartbeatTimeout: 2*cfg.GRPC.HealthWatchInterval + cfg.Health.Timeout,
+	}
+}
+
+// ProvideHealthServer provides a gRPC health server
+func ProvideHealthServer(
+	logger logger.Logger,
+	serverConfig healthGrpc.HealthServerConfig,
+	healthService *healthQuery.HealthService,
+	livenessService *healthQuery.LivenessService,
+	readinessService *healthQuery.ReadinessService,
+	watchdog ports.Watchdog,
+) *healthGrpc.HealthServer {
+	return healthGrpc.NewHealthServer(logger.Named(loggerName), serverConfig, healthService, livenessService, readinessService, watchdog)
 }
 
 // ProbesSet is a wire provider set for all probes dependencies
@@ -63,9 +241,33 @@ var ProbesSet = wire.NewSet(
 	ProvidePingHandler,
 	ProvideDatabaseChecker,
 	ProvideRedisChecker,
+	ProvideHealthCheckers,
+	ProvideHealthCheckerRegistry,
+	ProvideHealthQueryConfig,
+	ProvideHealthMetricsRecorder,
 	ProvideHealthQueryHandler,
+	ProvideHealthPollerConfig,
+	ProvideHealthPoller,
 	ProvideHealthService,
+	ProvideWatchdog,
+	ProvideLivenessQueryConfig,
 	ProvideLivenessQueryHandler,
 	ProvideLivenessService,
+	ProvideLifecycle,
+	ProvideMarkReadyCommandHandler,
+	ProvideStartDrainingCommandHandler,
+	ProvideLifecycleService,
+	ProvideReadinessQueryHandler,
+	ProvideReadinessService,
+	ProvideStartupQueryHandler,
+	ProvideStartupService,
+	ProvideHealthHandlerConfig,
 	ProvideHealthHandler,
+	ProvideBuildInfoProvider,
+	ProvideInfoQueryConfig,
+	ProvideInfoQueryHandler,
+	ProvideInfoService,
+	ProvideInfoHandler,
+	ProvideHealthServerConfig,
+	ProvideHealthServer,
 )
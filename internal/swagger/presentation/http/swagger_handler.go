@@ -29,17 +29,18 @@ func NewDocsHandler(logger logger.Logger, swaggerQueryHandler *query.SwaggerQuer
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/docs/openapi.yaml [get]
 func (h *DocsHandler) GetOpenAPISpec(c *fiber.Ctx) error {
-	h.logger.Info().Str("endpoint", "/openapi.yaml").Msg("OpenAPI specification requested")
+	log := h.logger.FromContext(c.UserContext())
+	log.Info().Str("endpoint", "/openapi.yaml").Msg("OpenAPI specification requested")
 	spec, err := h.swaggerQueryHandler.GetOpenAPISpec()
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to serve OpenAPI specification")
+		log.Error().Err(err).Msg("Failed to serve OpenAPI specification")
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to load OpenAPI specification",
 		})
 	}
 
 	c.Set("Content-Type", "text/yaml")
-	h.logger.Debug().Int("size_bytes", len(spec)).Msg("OpenAPI specification served successfully")
+	log.Debug().Int("size_bytes", len(spec)).Msg("OpenAPI specification served successfully")
 	return c.Send(spec)
 }
 
@@ -52,17 +53,18 @@ func (h *DocsHandler) GetOpenAPISpec(c *fiber.Ctx) error {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/docs [get]
 func (h *DocsHandler) GetSwaggerUI(c *fiber.Ctx) error {
-	h.logger.Info().Str("endpoint", "/swagger").Msg("Swagger UI requested")
+	log := h.logger.FromContext(c.UserContext())
+	log.Info().Str("endpoint", "/swagger").Msg("Swagger UI requested")
 	html, err := h.swaggerQueryHandler.GetSwaggerHTML()
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to serve Swagger UI")
+		log.Error().Err(err).Msg("Failed to serve Swagger UI")
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to generate Swagger UI",
 		})
 	}
 
 	c.Set("Content-Type", "text/html")
-	h.logger.Debug().Int("size_bytes", len(html)).Msg("Swagger UI served successfully")
+	log.Debug().Int("size_bytes", len(html)).Msg("Swagger UI served successfully")
 	return c.Send(html)
 }
 
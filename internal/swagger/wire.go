@@ -8,6 +8,9 @@ import (
 	"github.com/google/wire"
 )
 
+// loggerName is the name of the logger used by the swagger module
+const loggerName = "swagger"
+
 // ProvideSwaggerConfig provides swagger configuration
 func ProvideSwaggerConfig() infrastructure.SwaggerConfig {
 	return infrastructure.SwaggerConfig{
@@ -18,7 +21,7 @@ func ProvideSwaggerConfig() infrastructure.SwaggerConfig {
 
 // ProvideSwaggerLoader provides a swagger loader
 func ProvideSwaggerLoader(logger logger.Logger, config infrastructure.SwaggerConfig) (*infrastructure.SwaggerLoader, error) {
-	loader := infrastructure.NewSwaggerLoader(logger, config)
+	loader := infrastructure.NewSwaggerLoader(logger.Named(loggerName), config)
 	if err := loader.Init(); err != nil {
 		return nil, err
 	}
@@ -27,12 +30,12 @@ func ProvideSwaggerLoader(logger logger.Logger, config infrastructure.SwaggerCon
 
 // ProvideSwaggerQueryHandler provides a swagger query handler
 func ProvideSwaggerQueryHandler(logger logger.Logger, swaggerLoader *infrastructure.SwaggerLoader) *swaggerQuery.SwaggerQueryHandler {
-	return swaggerQuery.NewSwaggerQueryHandler(logger, swaggerLoader)
+	return swaggerQuery.NewSwaggerQueryHandler(logger.Named(loggerName), swaggerLoader)
 }
 
 // ProvideDocsHandler provides a docs HTTP handler
 func ProvideDocsHandler(logger logger.Logger, swaggerQueryHandler *swaggerQuery.SwaggerQueryHandler) *swaggerHttp.DocsHandler {
-	return swaggerHttp.NewDocsHandler(logger, swaggerQueryHandler)
+	return swaggerHttp.NewDocsHandler(logger.Named(loggerName), swaggerQueryHandler)
 }
 
 // SwaggerSet is a wire provider set for all swagger dependencies
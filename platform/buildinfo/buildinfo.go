@@ -0,0 +1,96 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+// Build metadata overridable at link time, e.g.
+//
+//	go build -ldflags "-X github.com/go-clean/platform/buildinfo.Version=1.2.3 \
+//	  -X github.com/go-clean/platform/buildinfo.Revision=$(git rev-parse HEAD) \
+//	  -X github.com/go-clean/platform/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Empty values fall back to the VCS metadata embedded by the Go toolchain.
+var (
+	Version   string
+	Revision  string
+	BuildTime string
+)
+
+// startTime is when the process started
+var startTime = time.Now().UTC()
+
+// Module describes a Go module the binary was built with
+type Module struct {
+	Path    string
+	Version string
+}
+
+// Info holds build and runtime metadata of the running binary
+type Info struct {
+	Version      string
+	Revision     string
+	RevisionTime string
+	Modified     bool
+	BuildTime    string
+	GoVersion    string
+	Path         string
+	Dependencies []Module
+	StartTime    time.Time
+}
+
+// Read returns the build metadata of the running binary, preferring
+// link-time overrides over the VCS settings embedded by the toolchain
+func Read() Info {
+	info := Info{
+		GoVersion: runtime.Version(),
+		StartTime: startTime,
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.Path = bi.Main.Path
+		if bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			info.Version = bi.Main.Version
+		}
+		if bi.GoVersion != "" {
+			info.GoVersion = bi.GoVersion
+		}
+
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				info.Revision = setting.Value
+			case "vcs.time":
+				info.RevisionTime = setting.Value
+			case "vcs.modified":
+				info.Modified = setting.Value == "true"
+			}
+		}
+
+		for _, dep := range bi.Deps {
+			if dep.Replace != nil {
+				dep = dep.Replace
+			}
+			info.Dependencies = append(info.Dependencies, Module{Path: dep.Path, Version: dep.Version})
+		}
+	}
+
+	if Version != "" {
+		info.Version = Version
+	}
+	if Revision != "" {
+		info.Revision = Revision
+	}
+	if BuildTime != "" {
+		info.BuildTime = BuildTime
+	}
+
+	return info
+}
+
+// StartTime returns when the process started
+func StartTime() time.Time {
+	return startTime
+}
@@ -1,6 +1,10 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -11,6 +15,7 @@ import (
 // Config holds all configuration for the application
 type Config struct {
 	Server    ServerConfig    `mapstructure:"server"`
+	GRPC      GRPCConfig      `mapstructure:"grpc"`
 	Database  DatabaseConfig  `mapstructure:"database"`
 	Redis     RedisConfig     `mapstructure:"redis"`
 	Logging   LoggingConfig   `mapstructure:"logging"`
@@ -19,114 +24,313 @@ type Config struct {
 	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
 	Health    HealthConfig    `mapstructure:"health"`
 	Swagger   SwaggerConfig   `mapstructure:"swagger"`
+	Metrics   MetricsConfig   `mapstructure:"metrics"`
+	Tracing   TracingConfig   `mapstructure:"tracing"`
+	Admin     AdminConfig     `mapstructure:"admin"`
+
+	// sources maps every configuration key to the source of its value
+	sources map[string]string
 }
 
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
-	Port         string        `mapstructure:"port"`
-	Host         string        `mapstructure:"host"`
-	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
-	WriteTimeout time.Duration `mapstructure:"write_timeout"`
-	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+	Port         string        `mapstructure:"port" validate:"required,port"`
+	Host         string        `mapstructure:"host" validate:"omitempty,hostname_rfc1123|ip"`
+	ReadTimeout  time.Duration `mapstructure:"read_timeout" validate:"gte=0"`
+	WriteTimeout time.Duration `mapstructure:"write_timeout" validate:"gte=0"`
+	IdleTimeout  time.Duration `mapstructure:"idle_timeout" validate:"gte=0"`
+	DrainPeriod  time.Duration `mapstructure:"drain_period" validate:"gte=0"`
+	// BodyLimit is the maximum request body size in bytes
+	BodyLimit int `mapstructure:"body_limit" validate:"min=1"`
+	// Concurrency is the maximum number of concurrent connections
+	Concurrency int `mapstructure:"concurrency" validate:"min=1"`
+	// MaxHeaderSize is the per-connection read buffer size in bytes, which limits the request header size
+	MaxHeaderSize int `mapstructure:"max_header_size" validate:"min=1"`
+	// ProxyHeader is the header the client IP is read from behind a proxy, e.g. X-Forwarded-For
+	ProxyHeader string `mapstructure:"proxy_header"`
+	// TrustedProxies are the IPs or CIDR ranges whose proxy headers are trusted, all are trusted when empty
+	TrustedProxies []string `mapstructure:"trusted_proxies" validate:"dive,ip|cidr"`
+	// Prefork spawns one process per CPU listening on the same port
+	Prefork bool `mapstructure:"prefork"`
+}
+
+// GRPCConfig holds gRPC server configuration
+type GRPCConfig struct {
+	Enabled             bool          `mapstructure:"enabled"`
+	Port                string        `mapstructure:"port" validate:"required_if=Enabled true,omitempty,port"`
+	Host                string        `mapstructure:"host" validate:"omitempty,hostname_rfc1123|ip"`
+	ShutdownTimeout     time.Duration `mapstructure:"shutdown_timeout" validate:"gt=0"`
+	HealthWatchInterval time.Duration `mapstructure:"health_watch_interval" validate:"gt=0"`
 }
 
 // DatabaseConfig holds database-related configuration
 type DatabaseConfig struct {
-	Host            string        `mapstructure:"host"`
-	Port            int           `mapstructure:"port"`
-	User            string        `mapstructure:"user"`
+	Host            string        `mapstructure:"host" validate:"required"`
+	Port            int           `mapstructure:"port" validate:"min=1,max=65535"`
+	User            string        `mapstructure:"user" validate:"required"`
 	Password        string        `mapstructure:"password"`
-	DBName          string        `mapstructure:"dbname"`
-	SSLMode         string        `mapstructure:"sslmode"`
-	MaxOpenConns    int           `mapstructure:"max_open_conns"`
-	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
-	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	DBName          string        `mapstructure:"dbname" validate:"required"`
+	SSLMode         string        `mapstructure:"sslmode" validate:"oneof=disable allow prefer require verify-ca verify-full"`
+	MaxOpenConns    int           `mapstructure:"max_open_conns" validate:"min=1"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns" validate:"min=0,ltefield=MaxOpenConns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime" validate:"gte=0"`
 }
 
 // RedisConfig holds Redis-related configuration
 type RedisConfig struct {
-	Host         string `mapstructure:"host"`
-	Port         int    `mapstructure:"port"`
+	Host         string `mapstructure:"host" validate:"required"`
+	Port         int    `mapstructure:"port" validate:"min=1,max=65535"`
 	Password     string `mapstructure:"password"`
-	DB           int    `mapstructure:"db"`
-	PoolSize     int    `mapstructure:"pool_size"`
-	MinIdleConns int    `mapstructure:"min_idle_conns"`
+	DB           int    `mapstructure:"db" validate:"min=0"`
+	PoolSize     int    `mapstructure:"pool_size" validate:"min=0"`
+	MinIdleConns int    `mapstructure:"min_idle_conns" validate:"min=0"`
 }
 
 // LoggingConfig holds logging-related configuration
 type LoggingConfig struct {
-	Level  string `mapstructure:"level"`
-	Format string `mapstructure:"format"`
-	Output string `mapstructure:"output"`
+	Level       string        `mapstructure:"level" validate:"loglevel"`
+	Format      string        `mapstructure:"format" validate:"oneof=json console"`
+	Output      string        `mapstructure:"output" validate:"required"`
+	SignalLevel string        `mapstructure:"signal_level" validate:"loglevel"`
+	SignalTTL   time.Duration `mapstructure:"signal_ttl" validate:"gte=0"`
+	// RedactKeys are field keys whose values are masked in log entries
+	RedactKeys []string `mapstructure:"redact_keys"`
+	// RedactPatterns are additional regular expressions masked in logged values
+	RedactPatterns []string `mapstructure:"redact_patterns" validate:"dive,regexp"`
+	// Sampling maps level names to the sampler applied to repeated events at that level
+	Sampling  map[string]SamplerConfig `mapstructure:"sampling" validate:"dive,keys,loglevel,ne=fatal,endkeys,omitempty"`
+	Probes    ProbeLoggingConfig       `mapstructure:"probes"`
+	AccessLog AccessLogConfig          `mapstructure:"access_log"`
+}
+
+// AccessLogConfig holds configuration of the structured HTTP access log
+type AccessLogConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// SkipPaths are not logged, paths ending in "*" skip every path with that prefix
+	SkipPaths []string `mapstructure:"skip_paths" validate:"dive,startswith=/"`
+	// SlowThreshold logs requests taking at least this long as warnings (0 disables)
+	SlowThreshold time.Duration `mapstructure:"slow_threshold" validate:"gte=0"`
+}
+
+// SamplerConfig holds the sampling of one log level: each distinct message is logged
+// Burst times per Period, then once every Every occurrences (never when zero)
+type SamplerConfig struct {
+	Burst  int           `mapstructure:"burst" validate:"min=0"`
+	Period time.Duration `mapstructure:"period" validate:"gte=0"`
+	Every  int           `mapstructure:"every" validate:"min=0"`
+}
+
+// ProbeLoggingConfig holds how logs of requests to probe endpoints are reduced
+type ProbeLoggingConfig struct {
+	// Mode is full, sample or suppress
+	Mode     string                   `mapstructure:"mode" validate:"oneof=full sample suppress"`
+	Paths    []string                 `mapstructure:"paths" validate:"dive,startswith=/"`
+	Sampling map[string]SamplerConfig `mapstructure:"sampling" validate:"dive,keys,loglevel,ne=fatal,endkeys,omitempty"`
 }
 
 // AppConfig holds application-related configuration
 type AppConfig struct {
-	Name        string `mapstructure:"name"`
+	Name        string `mapstructure:"name" validate:"required"`
 	Version     string `mapstructure:"version"`
-	Environment string `mapstructure:"environment"`
+	Environment string `mapstructure:"environment" validate:"required"`
 	Debug       bool   `mapstructure:"debug"`
 }
 
 // CORSConfig holds CORS-related configuration
 type CORSConfig struct {
-	AllowedOrigins   []string `mapstructure:"allowed_origins"`
-	AllowedMethods   []string `mapstructure:"allowed_methods"`
+	// AllowedOrigins are exact origins, subdomain patterns such as https://*.example.com, or a single *
+	AllowedOrigins   []string `mapstructure:"allowed_origins" validate:"dive,origin"`
+	AllowedMethods   []string `mapstructure:"allowed_methods" validate:"dive,oneof=GET HEAD POST PUT PATCH DELETE OPTIONS"`
 	AllowedHeaders   []string `mapstructure:"allowed_headers"`
+	ExposedHeaders   []string `mapstructure:"exposed_headers"`
 	AllowCredentials bool     `mapstructure:"allow_credentials"`
-	MaxAge           int      `mapstructure:"max_age"`
+	MaxAge           int      `mapstructure:"max_age" validate:"min=0"`
+	// Routes override the policy for requests whose path starts with their prefix
+	Routes []CORSRouteConfig `mapstructure:"routes" validate:"dive"`
+}
+
+// CORSRouteConfig overrides the CORS policy of a route group, unset values are
+// inherited from the top-level CORS configuration
+type CORSRouteConfig struct {
+	Prefix           string   `mapstructure:"prefix" validate:"required,startswith=/"`
+	AllowedOrigins   []string `mapstructure:"allowed_origins" validate:"dive,origin"`
+	AllowedMethods   []string `mapstructure:"allowed_methods" validate:"dive,oneof=GET HEAD POST PUT PATCH DELETE OPTIONS"`
+	AllowedHeaders   []string `mapstructure:"allowed_headers"`
+	ExposedHeaders   []string `mapstructure:"exposed_headers"`
+	AllowCredentials *bool    `mapstructure:"allow_credentials"`
+	MaxAge           *int     `mapstructure:"max_age" validate:"omitempty,min=0"`
+}
+
+// Policy returns the CORS configuration of the route group, falling back to base
+// for every value the route does not set
+func (r CORSRouteConfig) Policy(base CORSConfig) CORSConfig {
+	policy := base
+	policy.Routes = nil
+	if r.AllowedOrigins != nil {
+		policy.AllowedOrigins = r.AllowedOrigins
+	}
+	if r.AllowedMethods != nil {
+		policy.AllowedMethods = r.AllowedMethods
+	}
+	if r.AllowedHeaders != nil {
+		policy.AllowedHeaders = r.AllowedHeaders
+	}
+	if r.ExposedHeaders != nil {
+		policy.ExposedHeaders = r.ExposedHeaders
+	}
+	if r.AllowCredentials != nil {
+		policy.AllowCredentials = *r.AllowCredentials
+	}
+	if r.MaxAge != nil {
+		policy.MaxAge = *r.MaxAge
+	}
+	return policy
 }
 
 // RateLimitConfig holds rate limiting configuration
 type RateLimitConfig struct {
 	Enabled           bool `mapstructure:"enabled"`
-	RequestsPerMinute int  `mapstructure:"requests_per_minute"`
-	Burst             int  `mapstructure:"burst"`
+	RequestsPerMinute int  `mapstructure:"requests_per_minute" validate:"required_if=Enabled true,min=0"`
+	Burst             int  `mapstructure:"burst" validate:"min=0"`
+	// Store is redis, shared by every replica with an in-memory fallback, or memory
+	Store string `mapstructure:"store" validate:"oneof=redis memory"`
+	// RedisTimeout bounds each Redis call, requests are served from memory once it elapses
+	RedisTimeout time.Duration `mapstructure:"redis_timeout" validate:"gt=0"`
+	// KeyBy identifies clients by ip, api_key or user, falling back to the IP when absent
+	KeyBy        string `mapstructure:"key_by" validate:"oneof=ip api_key user"`
+	APIKeyHeader string `mapstructure:"api_key_header" validate:"required_if=KeyBy api_key"`
+	KeyPrefix    string `mapstructure:"key_prefix"`
+	// ExemptPaths are never limited, paths ending in "*" exempt every path with that prefix
+	ExemptPaths []string `mapstructure:"exempt_paths" validate:"dive,startswith=/"`
+	// Routes override the policy for requests whose path starts with their prefix
+	Routes []RateLimitRouteConfig `mapstructure:"routes" validate:"dive"`
+}
+
+// RateLimitRouteConfig holds the rate limit policy of a route group, whose
+// requests are counted separately from other routes
+type RateLimitRouteConfig struct {
+	Prefix            string `mapstructure:"prefix" validate:"required,startswith=/"`
+	RequestsPerMinute int    `mapstructure:"requests_per_minute" validate:"min=1"`
+	Burst             int    `mapstructure:"burst" validate:"min=0"`
+	// KeyBy overrides the client identification of the top-level configuration when set
+	KeyBy string `mapstructure:"key_by" validate:"omitempty,oneof=ip api_key user"`
 }
 
 // HealthConfig holds health check configuration
 type HealthConfig struct {
-	DatabaseTimeout time.Duration `mapstructure:"database_timeout"`
-	RedisTimeout    time.Duration `mapstructure:"redis_timeout"`
+	Timeout           time.Duration `mapstructure:"timeout" validate:"gt=0"`
+	DatabaseTimeout   time.Duration `mapstructure:"database_timeout" validate:"gt=0"`
+	DatabaseCritical  bool          `mapstructure:"database_critical"`
+	RedisTimeout      time.Duration `mapstructure:"redis_timeout" validate:"gt=0"`
+	RedisCritical     bool          `mapstructure:"redis_critical"`
+	BackgroundRefresh bool          `mapstructure:"background_refresh"`
+	RefreshInterval   time.Duration `mapstructure:"refresh_interval" validate:"required_if=BackgroundRefresh true,gte=0"`
+	CacheMaxAge       time.Duration `mapstructure:"cache_max_age" validate:"gte=0"`
+	VerboseToken      string        `mapstructure:"verbose_token"`
+	MaxGoroutines     int           `mapstructure:"max_goroutines" validate:"min=0"`
 }
 
 // SwaggerConfig holds Swagger/API documentation configuration
 type SwaggerConfig struct {
 	Enabled  bool   `mapstructure:"enabled"`
-	FilePath string `mapstructure:"file_path"`
+	FilePath string `mapstructure:"file_path" validate:"required_if=Enabled true"`
+}
+
+// MetricsConfig holds Prometheus metrics configuration
+type MetricsConfig struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	Path      string `mapstructure:"path" validate:"required_if=Enabled true,omitempty,startswith=/"`
+	Namespace string `mapstructure:"namespace" validate:"required_if=Enabled true"`
+}
+
+// TracingConfig holds OpenTelemetry tracing configuration
+type TracingConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	Exporter    string  `mapstructure:"exporter" validate:"required_if=Enabled true,omitempty,oneof=otlp-grpc otlp-http stdout"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio" validate:"gte=0,lte=1"`
+}
+
+// AdminConfig holds configuration of the authenticated admin endpoints
+type AdminConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Token   string `mapstructure:"token"`
 }
 
-// Load loads configuration from environment variables and config files
-func Load(log logger.Logger) (*Config, error) {
+// Path is the configuration file given with the --config flag, empty to use
+// GO_CLEAN_CONFIG or search the default locations
+type Path string
+
+// ConfigEnvVar names the environment variable holding the configuration file path
+const ConfigEnvVar = "GO_CLEAN_CONFIG"
+
+// configSearchPaths are searched for config.yaml when no file is given
+var configSearchPaths = []string{"./configs", "."}
+
+// Load loads configuration from, in increasing precedence: defaults, the config
+// file, the profile file of the environment (config.<environment>.yaml next to it),
+// the .env file and environment variables
+func Load(log logger.Logger, path Path) (*Config, error) {
 	log.Debug().Msg("Starting configuration loading process")
 
 	// Set defaults
 	log.Debug().Msg("Setting default configuration values")
 	setDefaults()
 
+	// Variables of the .env file apply unless set in the environment
+	dotEnv, err := loadDotEnv(DotEnvFile)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read .env file")
+		return nil, err
+	}
+	if len(dotEnv) > 0 {
+		log.Info().Str("env_file", DotEnvFile).Int("variables", len(dotEnv)).Msg("Environment file loaded successfully")
+	}
+
 	// Set environment variable prefix
-	log.Debug().Str("prefix", "GO_CLEAN").Msg("Configuring environment variable prefix")
-	viper.SetEnvPrefix("GO_CLEAN")
+	log.Debug().Str("prefix", envPrefix).Msg("Configuring environment variable prefix")
+	viper.SetEnvPrefix(envPrefix)
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv()
 
-	// Try to read from config file
-	log.Debug().Msg("Attempting to read configuration file")
-	viper.SetConfigName("config")
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath("./configs")
-	viper.AddConfigPath(".")
+	if path == "" {
+		path = Path(os.Getenv(ConfigEnvVar))
+	}
 
-	// Reading config file is optional
+	// An explicit config file must exist, the default locations are optional
+	log.Debug().Str("config_file", string(path)).Msg("Attempting to read configuration file")
+	viper.SetConfigType("yaml")
+	if path != "" {
+		viper.SetConfigFile(string(path))
+	} else {
+		viper.SetConfigName("config")
+		for _, searchPath := range configSearchPaths {
+			viper.AddConfigPath(searchPath)
+		}
+	}
 	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			log.Error().Err(err).Msg("Failed to read configuration file")
-			return nil, err
+		var notFound viper.ConfigFileNotFoundError
+		if path != "" || !errors.As(err, &notFound) {
+			log.Error().Err(err).Str("config_file", string(path)).Msg("Failed to read configuration file")
+			return nil, fmt.Errorf("failed to read configuration file: %w", err)
 		}
 		log.Debug().Msg("Configuration file not found, using defaults and environment variables")
 	} else {
 		log.Info().Str("config_file", viper.ConfigFileUsed()).Msg("Configuration file loaded successfully")
 	}
+	file := viper.ConfigFileUsed()
+
+	profile, err := mergeProfile(file, viper.GetString("app.environment"))
+	if err != nil {
+		log.Error().Err(err).Str("profile_file", profile).Msg("Failed to read configuration profile")
+		return nil, err
+	}
+	if profile != "" {
+		log.Info().Str("profile_file", profile).Msg("Configuration profile loaded successfully")
+	}
+
+	sources := valueSources{file: readLayer(file), profile: readLayer(profile), dotEnv: dotEnv}
 
 	var config Config
 	log.Debug().Msg("Unmarshaling configuration")
@@ -134,19 +338,103 @@ func Load(log logger.Logger) (*Config, error) {
 		log.Error().Err(err).Msg("Failed to unmarshal configuration")
 		return nil, err
 	}
+	config.sources = sources.sources(viper.AllKeys())
+
+	log.Debug().Msg("Validating configuration")
+	if err := config.Validate(); err != nil {
+		log.Error().Err(err).Msg("Configuration is invalid")
+		return nil, err
+	}
 
 	log.Debug().Msg("Configuration loaded and validated successfully")
 	return &config, nil
 }
 
+// Sources maps every configuration key to the source of its value: default, file,
+// profile, dotenv or env
+func (c Config) Sources() map[string]string {
+	return c.sources
+}
+
+// mergeProfile merges the profile of the environment, config.<environment>.yaml in
+// the directory of the config file, returning its path or empty when there is none
+func mergeProfile(file, environment string) (string, error) {
+	environment = strings.ToLower(strings.TrimSpace(environment))
+	if environment == "" || strings.ContainsAny(environment, `/\.`) {
+		return "", nil
+	}
+
+	dirs := configSearchPaths
+	ext := ".yaml"
+	if file != "" {
+		dirs = []string{filepath.Dir(file)}
+		ext = filepath.Ext(file)
+	}
+	for _, dir := range dirs {
+		profile := filepath.Join(dir, "config."+environment+ext)
+		if _, err := os.Stat(profile); err != nil {
+			continue
+		}
+		viper.SetConfigFile(profile)
+		if err := viper.MergeInConfig(); err != nil {
+			return profile, fmt.Errorf("failed to merge configuration profile: %w", err)
+		}
+		return profile, nil
+	}
+	return "", nil
+}
+
+// redactedValue replaces secrets in configuration dumps
+const redactedValue = "[REDACTED]"
+
+// Redacted returns a copy of the configuration with every secret masked
+func (c Config) Redacted() Config {
+	c.Database.Password = redact(c.Database.Password)
+	c.Redis.Password = redact(c.Redis.Password)
+	c.Health.VerboseToken = redact(c.Health.VerboseToken)
+	c.Admin.Token = redact(c.Admin.Token)
+	return c
+}
+
+// String returns a dump of the configuration that never contains secrets
+func (c Config) String() string {
+	// plain has no String method, so formatting it does not recurse
+	type plain Config
+	dump := plain(c.Redacted())
+	dump.sources = nil
+	return fmt.Sprintf("%+v", dump)
+}
+
+// redact masks a secret, keeping empty values visible so missing secrets can be spotted
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redactedValue
+}
+
 // setDefaults sets default values for all configuration sections
 func setDefaults() {
 	// Server defaults
 	viper.SetDefault("server.port", "8080")
-	viper.SetDefault("server.host", "localhost")
+	viper.SetDefault("server.host", "0.0.0.0")
 	viper.SetDefault("server.read_timeout", "30s")
 	viper.SetDefault("server.write_timeout", "30s")
 	viper.SetDefault("server.idle_timeout", "120s")
+	viper.SetDefault("server.drain_period", "5s")
+	viper.SetDefault("server.body_limit", 4*1024*1024)
+	viper.SetDefault("server.concurrency", 256*1024)
+	viper.SetDefault("server.max_header_size", 4096)
+	viper.SetDefault("server.proxy_header", "")
+	viper.SetDefault("server.trusted_proxies", []string{})
+	viper.SetDefault("server.prefork", false)
+
+	// gRPC defaults
+	viper.SetDefault("grpc.enabled", false)
+	viper.SetDefault("grpc.port", "9090")
+	viper.SetDefault("grpc.host", "0.0.0.0")
+	viper.SetDefault("grpc.shutdown_timeout", "10s")
+	viper.SetDefault("grpc.health_watch_interval", "5s")
 
 	// Database defaults
 	viper.SetDefault("database.host", "localhost")
@@ -171,6 +459,20 @@ func setDefaults() {
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.format", "json")
 	viper.SetDefault("logging.output", "stdout")
+	viper.SetDefault("logging.signal_level", "debug")
+	viper.SetDefault("logging.signal_ttl", "10m")
+	viper.SetDefault("logging.redact_keys", []string{"password", "passwd", "secret", "token", "authorization", "cookie", "api_key"})
+	viper.SetDefault("logging.redact_patterns", []string{})
+	viper.SetDefault("logging.sampling", map[string]any{})
+	viper.SetDefault("logging.probes.mode", "sample")
+	viper.SetDefault("logging.probes.paths", []string{"/ping", "/health", "/liveness", "/readyz", "/startupz", "/metrics"})
+	viper.SetDefault("logging.probes.sampling", map[string]any{
+		"debug": map[string]any{"burst": 1, "period": "1m", "every": 0},
+		"info":  map[string]any{"burst": 1, "period": "1m", "every": 0},
+	})
+	viper.SetDefault("logging.access_log.enabled", true)
+	viper.SetDefault("logging.access_log.skip_paths", []string{})
+	viper.SetDefault("logging.access_log.slow_threshold", "1s")
 
 	// App defaults
 	viper.SetDefault("app.name", "go-clean-api")
@@ -182,19 +484,52 @@ func setDefaults() {
 	viper.SetDefault("cors.allowed_origins", []string{"http://localhost:3000", "http://localhost:8080"})
 	viper.SetDefault("cors.allowed_methods", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"})
 	viper.SetDefault("cors.allowed_headers", []string{"Content-Type", "Authorization", "X-Requested-With"})
+	viper.SetDefault("cors.exposed_headers", []string{"X-Request-ID", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After"})
 	viper.SetDefault("cors.allow_credentials", true)
 	viper.SetDefault("cors.max_age", 86400)
+	viper.SetDefault("cors.routes", []map[string]any{})
 
 	// Rate limit defaults
 	viper.SetDefault("rate_limit.enabled", true)
 	viper.SetDefault("rate_limit.requests_per_minute", 100)
 	viper.SetDefault("rate_limit.burst", 10)
+	viper.SetDefault("rate_limit.store", "redis")
+	viper.SetDefault("rate_limit.redis_timeout", "50ms")
+	viper.SetDefault("rate_limit.key_by", "ip")
+	viper.SetDefault("rate_limit.api_key_header", "X-API-Key")
+	viper.SetDefault("rate_limit.key_prefix", "ratelimit:")
+	viper.SetDefault("rate_limit.exempt_paths", []string{"/ping", "/health", "/liveness", "/readyz", "/startupz", "/metrics"})
+	viper.SetDefault("rate_limit.routes", []map[string]any{})
 
 	// Health check defaults
+	viper.SetDefault("health.timeout", "5s")
 	viper.SetDefault("health.database_timeout", "5s")
+	viper.SetDefault("health.database_critical", true)
 	viper.SetDefault("health.redis_timeout", "3s")
+	viper.SetDefault("health.redis_critical", true)
+	viper.SetDefault("health.background_refresh", false)
+	viper.SetDefault("health.refresh_interval", "10s")
+	viper.SetDefault("health.cache_max_age", "30s")
+	viper.SetDefault("health.verbose_token", "")
+	viper.SetDefault("health.max_goroutines", 0)
 
 	// Swagger defaults
 	viper.SetDefault("swagger.enabled", true)
 	viper.SetDefault("swagger.file_path", "./api/swagger.html")
+
+	// Metrics defaults
+	viper.SetDefault("metrics.enabled", true)
+	viper.SetDefault("metrics.path", "/metrics")
+	viper.SetDefault("metrics.namespace", "go_clean")
+
+	// Tracing defaults
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.exporter", "otlp-grpc")
+	viper.SetDefault("tracing.endpoint", "localhost:4317")
+	viper.SetDefault("tracing.insecure", true)
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+
+	// Admin defaults
+	viper.SetDefault("admin.enabled", true)
+	viper.SetDefault("admin.token", "")
 }
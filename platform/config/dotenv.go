@@ -0,0 +1,108 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// DotEnvFile is the file environment variables are read from for local development
+const DotEnvFile = ".env"
+
+// envNamePattern matches valid environment variable names
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// loadDotEnv sets the variables of a .env file that are not already set in the
+// environment, returning their names; a missing file is not an error.
+// Lines hold KEY=value, optionally prefixed with export; values may be single
+// quoted (literal) or double quoted (with \n, \t, \" and \\ escapes), unquoted
+// values end at a " #" comment.
+func loadDotEnv(path string) (map[string]struct{}, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	loaded := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, err := parseDotEnvLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, number, err)
+		}
+		if _, set := os.LookupEnv(key); set {
+			// The real environment takes precedence over the file
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, number, err)
+		}
+		loaded[key] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return loaded, nil
+}
+
+// parseDotEnvLine splits a trimmed, non-comment .env line into its key and value
+func parseDotEnvLine(line string) (string, string, error) {
+	line = strings.TrimPrefix(line, "export ")
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return "", "", fmt.Errorf("expected KEY=value")
+	}
+	key = strings.TrimSpace(key)
+	if !envNamePattern.MatchString(key) {
+		return "", "", fmt.Errorf("invalid variable name %q", key)
+	}
+	value = strings.TrimSpace(value)
+
+	switch {
+	case strings.HasPrefix(value, "'"):
+		end := strings.Index(value[1:], "'")
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated single quoted value of %s", key)
+		}
+		return key, value[1 : end+1], nil
+	case strings.HasPrefix(value, `"`):
+		var b strings.Builder
+		for i := 1; i < len(value); i++ {
+			switch c := value[i]; {
+			case c == '"':
+				return key, b.String(), nil
+			case c == '\\' && i+1 < len(value):
+				i++
+				switch value[i] {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				default:
+					b.WriteByte(value[i])
+				}
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return "", "", fmt.Errorf("unterminated double quoted value of %s", key)
+	default:
+		if comment := strings.Index(value, " #"); comment >= 0 {
+			value = strings.TrimSpace(value[:comment])
+		}
+		return key, value, nil
+	}
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Sources a configuration value can come from, from lowest to highest precedence
+const (
+	SourceDefault = "default"
+	SourceFile    = "file"
+	SourceProfile = "profile"
+	SourceDotEnv  = "dotenv"
+	SourceEnv     = "env"
+)
+
+// valueSources records what each configuration layer set, to report where every
+// value came from
+type valueSources struct {
+	file    *viper.Viper
+	profile *viper.Viper
+	dotEnv  map[string]struct{}
+}
+
+// readLayer reads a single configuration file into its own viper instance
+func readLayer(path string) *viper.Viper {
+	layer := viper.New()
+	if path == "" {
+		return layer
+	}
+	layer.SetConfigFile(path)
+	// The file was already read successfully into the merged configuration
+	_ = layer.ReadInConfig()
+	return layer
+}
+
+// source returns the source of the value of a configuration key
+func (s valueSources) source(key string) string {
+	name := envVarName(key)
+	if _, set := os.LookupEnv(name); set {
+		if _, ok := s.dotEnv[name]; ok {
+			return SourceDotEnv
+		}
+		return SourceEnv
+	}
+	if s.profile != nil && s.profile.IsSet(key) {
+		return SourceProfile
+	}
+	if s.file != nil && s.file.IsSet(key) {
+		return SourceFile
+	}
+	return SourceDefault
+}
+
+// sources maps every configuration key to the source of its value
+func (s valueSources) sources(keys []string) map[string]string {
+	sources := make(map[string]string, len(keys))
+	for _, key := range keys {
+		sources[key] = s.source(key)
+	}
+	return sources
+}
+
+// envVarName returns the environment variable overriding a configuration key
+func envVarName(key string) string {
+	return envPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
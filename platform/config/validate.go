@@ -0,0 +1,293 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// EnvironmentProduction is the AppConfig.Environment value production-only rules apply to
+const EnvironmentProduction = "production"
+
+// envPrefix is the prefix of the environment variables overriding configuration keys
+const envPrefix = "GO_CLEAN"
+
+// Violation describes a configuration value breaking a validation rule
+type Violation struct {
+	// Key is the configuration key, e.g. database.port
+	Key string
+	// EnvVar is the environment variable overriding the key, e.g. GO_CLEAN_DATABASE_PORT
+	EnvVar string
+	// Message describes the rule that was broken
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s (%s) %s", v.Key, v.EnvVar, v.Message)
+}
+
+// ValidationError lists every violation found in a configuration
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid configuration, %d violation(s):", len(e.Violations))
+	for _, violation := range e.Violations {
+		b.WriteString("\n  - ")
+		b.WriteString(violation.String())
+	}
+	return b.String()
+}
+
+// newViolation builds a violation for a configuration key, list elements and map
+// entries such as logging.sampling[debug] are overridden through the variable of the whole value
+func newViolation(key, message string) Violation {
+	whole, _, _ := strings.Cut(key, "[")
+	return Violation{
+		Key:     key,
+		EnvVar:  envVarName(whole),
+		Message: message,
+	}
+}
+
+// logLevels are the level names accepted by the logger
+var logLevels = map[string]struct{}{
+	"trace": {}, "debug": {}, "info": {}, "warn": {}, "error": {}, "fatal": {},
+}
+
+// newValidator creates a validator reporting fields by their configuration keys
+func newValidator() *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+	// Errors are impossible here, the tags are valid and not reserved
+	_ = validate.RegisterValidation("port", func(fl validator.FieldLevel) bool {
+		port, err := strconv.Atoi(fl.Field().String())
+		return err == nil && port >= 1 && port <= 65535
+	})
+	_ = validate.RegisterValidation("loglevel", func(fl validator.FieldLevel) bool {
+		_, ok := logLevels[strings.ToLower(fl.Field().String())]
+		return ok
+	})
+	_ = validate.RegisterValidation("origin", func(fl validator.FieldLevel) bool {
+		return validOrigin(fl.Field().String())
+	})
+	_ = validate.RegisterValidation("regexp", func(fl validator.FieldLevel) bool {
+		_, err := regexp.Compile(fl.Field().String())
+		return err == nil
+	})
+	return validate
+}
+
+// Validate checks every configuration value against the rules declared in the
+// validate tags and the environment rules, returning a *ValidationError listing
+// all violations
+func (c Config) Validate() error {
+	var violations []Violation
+
+	err := newValidator().Struct(c)
+	var fieldErrors validator.ValidationErrors
+	if errors.As(err, &fieldErrors) {
+		for _, fieldError := range fieldErrors {
+			key := fieldKey(fieldError)
+			violations = append(violations, newViolation(key, fieldMessage(key, fieldError)))
+		}
+	} else if err != nil {
+		return err
+	}
+
+	violations = append(violations, c.sectionViolations()...)
+	violations = append(violations, c.environmentViolations()...)
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// sectionViolations applies the rules spanning several configuration sections
+func (c Config) sectionViolations() []Violation {
+	violations := c.preforkViolations()
+	if c.Health.MaxGoroutines > 0 && c.Health.MaxGoroutines <= c.Server.Concurrency {
+		// One goroutine runs per active connection, a busy instance would be reported dead
+		violations = append(violations, newViolation("health.max_goroutines", fmt.Sprintf("must be 0 or greater than server.concurrency (%d)", c.Server.Concurrency)))
+	}
+	if c.Health.BackgroundRefresh && c.Health.CacheMaxAge > 0 && c.Health.RefreshInterval >= c.Health.CacheMaxAge {
+		// The cached result would expire before the next refresh, forcing live checks
+		violations = append(violations, newViolation("health.refresh_interval", fmt.Sprintf("must be less than health.cache_max_age (%s) when health.background_refresh is true", c.Health.CacheMaxAge)))
+	}
+	violations = append(violations, corsViolations("cors", c.CORS)...)
+	for i, route := range c.CORS.Routes {
+		violations = append(violations, corsViolations(fmt.Sprintf("cors.routes[%d]", i), route.Policy(c.CORS))...)
+	}
+	return violations
+}
+
+// preforkViolations rejects prefork together with the features that break when
+// every preforked child runs its own copy of the application: children only exit
+// when the parent does, without draining, and keep separate state and metrics
+func (c Config) preforkViolations() []Violation {
+	if !c.Server.Prefork {
+		return nil
+	}
+
+	conflicts := []struct {
+		enabled bool
+		key     string
+		reason  string
+	}{
+		{c.GRPC.Enabled, "grpc.enabled", "every child would bind the gRPC port"},
+		{c.Metrics.Enabled, "metrics.enabled", "every child would expose its own partial metrics"},
+		{c.Admin.Enabled, "admin.enabled", "log level changes would only reach the child serving the request"},
+		{c.Health.BackgroundRefresh, "health.background_refresh", "every child would poll the dependencies"},
+		{c.Server.DrainPeriod > 0, "server.drain_period", "only the parent receives SIGTERM, children are not drained"},
+	}
+	var violations []Violation
+	for _, conflict := range conflicts {
+		if conflict.enabled {
+			violations = append(violations, newViolation("server.prefork",
+				fmt.Sprintf("must be false when %s is set, %s", conflict.key, conflict.reason)))
+		}
+	}
+	return violations
+}
+
+// corsViolations checks the origins of a CORS policy against its other settings
+func corsViolations(key string, policy CORSConfig) []Violation {
+	wildcard := false
+	for _, origin := range policy.AllowedOrigins {
+		if origin == "*" {
+			wildcard = true
+		}
+	}
+	if !wildcard {
+		return nil
+	}
+
+	var violations []Violation
+	if len(policy.AllowedOrigins) > 1 {
+		violations = append(violations, newViolation(key+".allowed_origins", "must not list other origins next to *"))
+	}
+	if policy.AllowCredentials {
+		// Browsers would send cookies and authorization headers to any site
+		violations = append(violations, newViolation(key+".allow_credentials", "must be false when allowed_origins contains *"))
+	}
+	return violations
+}
+
+// validOrigin reports whether origin is *, or a scheme and host with an optional
+// port and a leading *. subdomain wildcard, as accepted by the CORS middleware
+func validOrigin(origin string) bool {
+	if origin == "*" {
+		return true
+	}
+	parsed, err := url.Parse(strings.Replace(origin, "://*.", "://", 1))
+	if err != nil {
+		return false
+	}
+	// Only the leading subdomain may be a wildcard
+	if strings.Contains(parsed.Host, "*") {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" &&
+		parsed.User == nil && parsed.Path == "" && parsed.RawQuery == "" && parsed.Fragment == ""
+}
+
+// environmentViolations applies the rules that depend on AppConfig.Environment
+func (c Config) environmentViolations() []Violation {
+	if !strings.EqualFold(c.App.Environment, EnvironmentProduction) {
+		return nil
+	}
+
+	var violations []Violation
+	if c.Database.SSLMode == "disable" {
+		violations = append(violations, newViolation("database.sslmode", "must not be disable in production"))
+	}
+	if c.App.Debug {
+		violations = append(violations, newViolation("app.debug", "must be false in production"))
+	}
+	if strings.EqualFold(c.Logging.Format, "console") {
+		violations = append(violations, newViolation("logging.format", "must be json in production"))
+	}
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			violations = append(violations, newViolation("cors.allowed_origins", "must not contain * in production"))
+			break
+		}
+	}
+	return violations
+}
+
+// fieldKey converts a validator namespace such as Config.logging.sampling[debug].burst
+// into the configuration key logging.sampling[debug].burst
+func fieldKey(fieldError validator.FieldError) string {
+	_, key, _ := strings.Cut(fieldError.Namespace(), ".")
+	return key
+}
+
+// fieldMessage describes the broken rule in plain words
+func fieldMessage(key string, fieldError validator.FieldError) string {
+	param := fieldError.Param()
+	switch fieldError.Tag() {
+	case "hostname_rfc1123|ip":
+		return fmt.Sprintf("must be a host name or IP address, got %q", fieldError.Value())
+	case "ip|cidr":
+		return fmt.Sprintf("must be an IP address or CIDR range, got %q", fieldError.Value())
+	case "required", "required_if":
+		return "is required"
+	case "port":
+		return fmt.Sprintf("must be a port number between 1 and 65535, got %q", fieldError.Value())
+	case "loglevel":
+		return fmt.Sprintf("must be one of trace, debug, info, warn, error or fatal, got %q", fieldError.Value())
+	case "origin":
+		return fmt.Sprintf("must be *, or a scheme and host such as https://example.com or https://*.example.com, got %q", fieldError.Value())
+	case "regexp":
+		return fmt.Sprintf("must be a valid regular expression, got %q", fieldError.Value())
+	case "oneof":
+		return fmt.Sprintf("must be one of %s, got %q", strings.Join(strings.Fields(param), ", "), fmt.Sprint(fieldError.Value()))
+	case "ne":
+		return fmt.Sprintf("must not be %s", param)
+	case "startswith":
+		return fmt.Sprintf("must start with %q, got %q", param, fieldError.Value())
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s, got %v", param, fieldError.Value())
+	case "max", "lte":
+		return fmt.Sprintf("must be at most %s, got %v", param, fieldError.Value())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s, got %v", param, fieldError.Value())
+	case "ltefield":
+		// The parameter is the Go name of a sibling field, report it by its key
+		sibling := strings.TrimSuffix(key, fieldError.Field()) + snakeCase(param)
+		return fmt.Sprintf("must not be greater than %s, got %v", sibling, fieldError.Value())
+	default:
+		return fmt.Sprintf("failed the %s rule", fieldError.Tag())
+	}
+}
+
+// snakeCase converts a Go field name such as MaxOpenConns into max_open_conns
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,211 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// defaultConfig returns the configuration built from the defaults alone
+func defaultConfig(t *testing.T) Config {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	setDefaults()
+
+	var cfg Config
+	if err := viper.Unmarshal(&cfg); err != nil {
+		t.Fatalf("failed to unmarshal defaults: %v", err)
+	}
+	return cfg
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		// want lists the expected violations by key and environment variable
+		want []Violation
+	}{
+		{
+			name:   "defaults",
+			modify: func(c *Config) {},
+		},
+		{
+			name:   "port out of range",
+			modify: func(c *Config) { c.Database.Port = 0 },
+			want:   []Violation{{Key: "database.port", EnvVar: "GO_CLEAN_DATABASE_PORT"}},
+		},
+		{
+			name:   "invalid host",
+			modify: func(c *Config) { c.Server.Host = "not a host" },
+			want:   []Violation{{Key: "server.host", EnvVar: "GO_CLEAN_SERVER_HOST"}},
+		},
+		{
+			name:   "unknown log level",
+			modify: func(c *Config) { c.Logging.Level = "verbose" },
+			want:   []Violation{{Key: "logging.level", EnvVar: "GO_CLEAN_LOGGING_LEVEL"}},
+		},
+		{
+			name:   "invalid CORS origin",
+			modify: func(c *Config) { c.CORS.AllowedOrigins = []string{"https://example.com/path"} },
+			want:   []Violation{{Key: "cors.allowed_origins[0]", EnvVar: "GO_CLEAN_CORS_ALLOWED_ORIGINS"}},
+		},
+		{
+			name: "wildcard origin with credentials",
+			modify: func(c *Config) {
+				c.CORS.AllowedOrigins = []string{"*"}
+				c.CORS.AllowCredentials = true
+			},
+			want: []Violation{{Key: "cors.allow_credentials", EnvVar: "GO_CLEAN_CORS_ALLOW_CREDENTIALS"}},
+		},
+		{
+			name: "goroutine limit below the connection limit",
+			modify: func(c *Config) {
+				c.Health.MaxGoroutines = 1000
+				c.Server.Concurrency = 1000
+			},
+			want: []Violation{{Key: "health.max_goroutines", EnvVar: "GO_CLEAN_HEALTH_MAX_GOROUTINES"}},
+		},
+		{
+			name: "goroutine limit above the connection limit",
+			modify: func(c *Config) {
+				c.Health.MaxGoroutines = 2000
+				c.Server.Concurrency = 1000
+			},
+		},
+		{
+			name: "refresh interval beyond the cache max age",
+			modify: func(c *Config) {
+				c.Health.BackgroundRefresh = true
+				c.Health.RefreshInterval = 30 * time.Second
+				c.Health.CacheMaxAge = 30 * time.Second
+			},
+			want: []Violation{{Key: "health.refresh_interval", EnvVar: "GO_CLEAN_HEALTH_REFRESH_INTERVAL"}},
+		},
+		{
+			name: "refresh interval without background refresh",
+			modify: func(c *Config) {
+				c.Health.RefreshInterval = time.Minute
+				c.Health.CacheMaxAge = 30 * time.Second
+			},
+		},
+		{
+			name: "prefork with per-process features",
+			modify: func(c *Config) {
+				c.Server.Prefork = true
+				c.GRPC.Enabled = true
+			},
+			want: []Violation{
+				{Key: "server.prefork", EnvVar: "GO_CLEAN_SERVER_PREFORK"},
+				{Key: "server.prefork", EnvVar: "GO_CLEAN_SERVER_PREFORK"},
+				{Key: "server.prefork", EnvVar: "GO_CLEAN_SERVER_PREFORK"},
+				{Key: "server.prefork", EnvVar: "GO_CLEAN_SERVER_PREFORK"},
+			},
+		},
+		{
+			name: "prefork alone",
+			modify: func(c *Config) {
+				c.Server.Prefork = true
+				c.Server.DrainPeriod = 0
+				c.Metrics.Enabled = false
+				c.Admin.Enabled = false
+			},
+		},
+		{
+			name: "production rules",
+			modify: func(c *Config) {
+				c.App.Environment = "Production"
+				c.Database.SSLMode = "disable"
+				c.App.Debug = true
+			},
+			want: []Violation{
+				{Key: "database.sslmode", EnvVar: "GO_CLEAN_DATABASE_SSLMODE"},
+				{Key: "app.debug", EnvVar: "GO_CLEAN_APP_DEBUG"},
+			},
+		},
+		{
+			name:   "development allows production-only violations",
+			modify: func(c *Config) { c.App.Debug = true },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaultConfig(t)
+			// The defaults are meant for local development
+			cfg.Database.SSLMode = "require"
+			cfg.App.Debug = false
+			tt.modify(&cfg)
+
+			err := cfg.Validate()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() = %v, want a *ValidationError", err)
+			}
+			if len(validationErr.Violations) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want %d violation(s)", err, len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := validationErr.Violations[i]
+				if got.Key != want.Key || got.EnvVar != want.EnvVar {
+					t.Errorf("violation %d = %s, want key %s (%s)", i, got, want.Key, want.EnvVar)
+				}
+				if got.Message == "" {
+					t.Errorf("violation %d has no message", i)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateReportsEveryViolation(t *testing.T) {
+	cfg := defaultConfig(t)
+	cfg.Server.Port = ""
+	cfg.Redis.Port = 70000
+	cfg.Health.Timeout = -time.Second
+
+	err := cfg.Validate()
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Validate() = %v, want a *ValidationError", err)
+	}
+	if got := len(validationErr.Violations); got != 3 {
+		t.Errorf("Validate() = %v, want 3 violations", err)
+	}
+}
+
+func TestValidOrigin(t *testing.T) {
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{"*", true},
+		{"https://example.com", true},
+		{"http://localhost:3000", true},
+		{"https://*.example.com", true},
+		{"https://*.*.example.com", false},
+		{"https://a.*.example.com", false},
+		{"https://*example.com", false},
+		{"example.com", false},
+		{"ftp://example.com", false},
+		{"https://example.com/", false},
+		{"https://user@example.com", false},
+		{"https://example.com?query", false},
+	}
+
+	for _, tt := range tests {
+		if got := validOrigin(tt.origin); got != tt.want {
+			t.Errorf("validOrigin(%q) = %t, want %t", tt.origin, got, tt.want)
+		}
+	}
+}
@@ -37,6 +37,7 @@ func NewConnection(cfg config.DatabaseConfig, log logger.Logger) (*pgxpool.Pool,
 	poolConfig.MinConns = int32(cfg.MaxIdleConns)
 	poolConfig.MaxConnLifetime = cfg.ConnMaxLifetime
 	poolConfig.MaxConnIdleTime = 30 * time.Minute
+	poolConfig.ConnConfig.Tracer = NewQueryTracer(cfg.DBName)
 
 	// Create connection pool
 	log.Debug().Int("max_conns", cfg.MaxOpenConns).Int("max_idle_conns", cfg.MaxIdleConns).Msg("Creating database connection pool")
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	"github.com/go-clean/platform/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryTracer implements pgx.QueryTracer, recording a client span for every query
+type QueryTracer struct {
+	dbName string
+}
+
+// NewQueryTracer creates a new pgx query tracer
+func NewQueryTracer(dbName string) *QueryTracer {
+	return &QueryTracer{dbName: dbName}
+}
+
+// TraceQueryStart starts a span for the query and stores it in the returned context
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = tracing.Tracer().Start(ctx, "postgresql.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBNamespace(t.dbName),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd ends the span started by TraceQueryStart
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		tracing.RecordError(span, data.Err)
+	} else {
+		span.SetAttributes(attribute.Int64("db.response.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/logger"
+	"google.golang.org/grpc"
+)
+
+// Server represents the gRPC server configuration
+type Server struct {
+	server *grpc.Server
+	config config.GRPCConfig
+	logger logger.Logger
+}
+
+// NewServer creates a new gRPC server
+func NewServer(cfg config.GRPCConfig, log logger.Logger) *Server {
+	log.Info().Bool("enabled", cfg.Enabled).Str("port", cfg.Port).Msg("Initializing gRPC server")
+
+	return &Server{
+		server: grpc.NewServer(),
+		config: cfg,
+		logger: log,
+	}
+}
+
+// GetServer returns the grpc server instance for service registration
+func (s *Server) GetServer() *grpc.Server {
+	return s.server
+}
+
+// Enabled reports whether the gRPC listener should be started
+func (s *Server) Enabled() bool {
+	return s.config.Enabled
+}
+
+// Start starts the gRPC server
+func (s *Server) Start() error {
+	address := net.JoinHostPort(s.config.Host, s.config.Port)
+	s.logger.Info().Str("address", address).Msg("Starting gRPC server")
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		s.logger.Error().Err(err).Str("address", address).Msg("Failed to listen for gRPC server")
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	if err := s.server.Serve(listener); err != nil {
+		s.logger.Error().Err(err).Str("address", address).Msg("Failed to start gRPC server")
+		return err
+	}
+	return nil
+}
+
+// Shutdown gracefully shuts down the server, forcing it to stop after the configured timeout
+func (s *Server) Shutdown() {
+	s.logger.Info().Msg("Shutting down gRPC server")
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		s.logger.Info().Msg("gRPC server shutdown completed")
+	case <-time.After(s.config.ShutdownTimeout):
+		s.logger.Warn().Msg("gRPC server did not stop gracefully in time, forcing shutdown")
+		s.server.Stop()
+	}
+}
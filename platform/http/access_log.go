@@ -0,0 +1,53 @@
+package http
+
+import (
+	"time"
+
+	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/http/response"
+	"github.com/go-clean/platform/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// accessLog writes one structured entry per request through the request-scoped
+// logger, which already carries the request ID, method, route and user ID.
+// Server errors are logged as errors and slow requests as warnings.
+func accessLog(log logger.Logger, cfg config.AccessLogConfig) fiber.Handler {
+	skip := pathMatcher(cfg.SkipPaths)
+
+	return func(c *fiber.Ctx) error {
+		if skip(c.Path()) {
+			return c.Next()
+		}
+
+		start := time.Now()
+		err := c.Next()
+		latency := time.Since(start)
+
+		// The error body is not written yet and is not counted in bytes_out
+		status := response.Status(c, err)
+
+		requestLog := log.FromContext(c.UserContext())
+		event := requestLog.Info()
+		slow := cfg.SlowThreshold > 0 && latency >= cfg.SlowThreshold
+		switch {
+		case status >= fiber.StatusInternalServerError:
+			event = requestLog.Error().Err(err)
+		case slow:
+			event = requestLog.Warn()
+		}
+
+		event.
+			Str("path", c.Path()).
+			Int("status", status).
+			Dur("latency_ms", latency).
+			Int("bytes_in", len(c.Request().Body())).
+			Int("bytes_out", len(c.Response().Body())).
+			Str("ip", c.IP()).
+			Str("user_agent", c.Get(fiber.HeaderUserAgent)).
+			Bool("slow", slow).
+			Msg("HTTP request completed")
+
+		return err
+	}
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-clean/platform/config"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// corsRoute is the CORS handler of a route group
+type corsRoute struct {
+	prefix  string
+	handler fiber.Handler
+}
+
+// corsMiddleware applies the CORS policy of the route group with the longest
+// prefix matching the request path, or the top-level policy when none matches
+func corsMiddleware(cfg config.CORSConfig) fiber.Handler {
+	base := newCORSHandler(cfg)
+
+	routes := make([]corsRoute, 0, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		routes = append(routes, corsRoute{
+			prefix:  strings.TrimSuffix(route.Prefix, "/"),
+			handler: newCORSHandler(route.Policy(cfg)),
+		})
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].prefix) > len(routes[j].prefix)
+	})
+
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		for _, route := range routes {
+			if hasPathPrefix(path, route.prefix) {
+				return route.handler(c)
+			}
+		}
+		return base(c)
+	}
+}
+
+// newCORSHandler creates the fiber CORS handler of a single policy, a policy
+// without origins denies every cross-origin request
+func newCORSHandler(policy config.CORSConfig) fiber.Handler {
+	cfg := cors.Config{
+		AllowOrigins:     strings.Join(policy.AllowedOrigins, ","),
+		AllowMethods:     strings.Join(policy.AllowedMethods, ","),
+		AllowHeaders:     strings.Join(policy.AllowedHeaders, ","),
+		ExposeHeaders:    strings.Join(policy.ExposedHeaders, ","),
+		AllowCredentials: policy.AllowCredentials,
+		MaxAge:           policy.MaxAge,
+	}
+	if len(policy.AllowedOrigins) == 0 {
+		// Fiber allows any origin when none is configured
+		cfg.AllowOriginsFunc = func(string) bool { return false }
+	}
+	return cors.New(cfg)
+}
+
+// hasPathPrefix reports whether path is prefix or one of its sub-paths, so /admin
+// matches /admin/log-levels but not /administrators
+func hasPathPrefix(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || path[len(prefix)] == '/'
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/ratelimit"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Rate limit response headers, as proposed by the IETF RateLimit header fields draft
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+)
+
+// Client identifications a rate limit can be keyed by
+const (
+	rateLimitKeyByIP     = "ip"
+	rateLimitKeyByAPIKey = "api_key"
+	rateLimitKeyByUser   = "user"
+)
+
+// rateLimitScope is the policy applied to a route group
+type rateLimitScope struct {
+	name   string
+	prefix string
+	policy ratelimit.Policy
+	keyBy  string
+}
+
+// rateLimiter rejects requests of clients exceeding the policy of the route group
+// with the longest prefix matching the request path, or the top-level policy.
+// Exempt paths are never limited, and requests are admitted when the store fails.
+func rateLimiter(cfg config.RateLimitConfig, store ratelimit.Store, log logger.Logger) fiber.Handler {
+	exempt := pathMatcher(cfg.ExemptPaths)
+	base := rateLimitScope{
+		name:   "default",
+		policy: ratelimit.Policy{RequestsPerMinute: cfg.RequestsPerMinute, Burst: cfg.Burst},
+		keyBy:  cfg.KeyBy,
+	}
+
+	scopes := make([]rateLimitScope, 0, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		scope := rateLimitScope{
+			name:   route.Prefix,
+			prefix: strings.TrimSuffix(route.Prefix, "/"),
+			policy: ratelimit.Policy{RequestsPerMinute: route.RequestsPerMinute, Burst: route.Burst},
+			keyBy:  route.KeyBy,
+		}
+		if scope.keyBy == "" {
+			scope.keyBy = cfg.KeyBy
+		}
+		scopes = append(scopes, scope)
+	}
+	sort.SliceStable(scopes, func(i, j int) bool {
+		return len(scopes[i].prefix) > len(scopes[j].prefix)
+	})
+
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		if exempt(path) {
+			return c.Next()
+		}
+		scope := base
+		for _, candidate := range scopes {
+			if hasPathPrefix(path, candidate.prefix) {
+				scope = candidate
+				break
+			}
+		}
+
+		kind, client := rateLimitClient(c, scope.keyBy, cfg.APIKeyHeader)
+		key := cfg.KeyPrefix + scope.name + ":" + kind + ":" + client
+		result, err := store.Allow(c.UserContext(), key, scope.policy)
+		if err != nil {
+			log.FromContext(c.UserContext()).Error().Err(err).Msg("Failed to apply rate limit, admitting request")
+			return c.Next()
+		}
+
+		window := scope.policy.Interval() * time.Duration(scope.policy.Capacity())
+		c.Set(HeaderRateLimitLimit, strconv.Itoa(result.Limit))
+		c.Set(HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+		c.Set(HeaderRateLimitReset, strconv.Itoa(ceilSeconds(result.ResetAfter)))
+		c.Set(HeaderRateLimitPolicy, strconv.Itoa(result.Limit)+";w="+strconv.Itoa(ceilSeconds(window)))
+
+		if !result.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			log.FromContext(c.UserContext()).Debug().Str("scope", scope.name).Str("key_by", kind).Msg("Rate limit exceeded")
+			// Answered here rather than through the error handler, which logs every error
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"error": "rate limit exceeded",
+				"code":  fiber.StatusTooManyRequests,
+			})
+		}
+		return c.Next()
+	}
+}
+
+// rateLimitClient identifies the client of a request, falling back to its IP when
+// the request carries no API key or no authenticated user
+func rateLimitClient(c *fiber.Ctx, keyBy, apiKeyHeader string) (string, string) {
+	switch keyBy {
+	case rateLimitKeyByAPIKey:
+		if apiKey := c.Get(apiKeyHeader); apiKey != "" {
+			// Keys are stored hashed so the store never holds credentials
+			sum := sha256.Sum256([]byte(apiKey))
+			return rateLimitKeyByAPIKey, hex.EncodeToString(sum[:16])
+		}
+	case rateLimitKeyByUser:
+		if userID, _ := c.Locals(LocalsUserID).(string); userID != "" {
+			return rateLimitKeyByUser, userID
+		}
+	}
+	return rateLimitKeyByIP, c.IP()
+}
+
+// ceilSeconds rounds a duration up to whole seconds for rate limit headers
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
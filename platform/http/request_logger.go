@@ -0,0 +1,98 @@
+package http
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-clean/platform/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LocalsUserID is the fiber.Ctx locals key authentication middleware stores the authenticated user ID under
+const LocalsUserID = "user_id"
+
+// requestLogger stores a request-scoped child logger in the user context, carrying
+// the request ID, method, route pattern, user ID and trace/span IDs, so every
+// layer logging through logger.FromContext(ctx) is correlated with the request.
+// Loggers of requests to probe endpoints are marked so their events are sampled.
+func requestLogger(log logger.Logger, isProbe func(path string) bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		self := c.Route()
+		ctx := c.UserContext()
+
+		// Route and user are only known once routing and authentication ran, so they
+		// are resolved lazily from the context while the request is in flight and
+		// frozen once it completes, because fiber recycles the context afterwards
+		var done atomic.Bool
+		var route, userID atomic.Value
+		resolveRoute := func() string {
+			if r := c.Route(); r != nil && r != self {
+				return r.Path
+			}
+			return ""
+		}
+		resolveUserID := func() string {
+			id, _ := c.Locals(LocalsUserID).(string)
+			return id
+		}
+
+		builder := log.With().
+			Str("request_id", c.GetRespHeader(fiber.HeaderXRequestID)).
+			Str("method", c.Method()).
+			StrFunc("route", func() string {
+				if done.Load() {
+					return route.Load().(string)
+				}
+				return resolveRoute()
+			}).
+			StrFunc("user_id", func() string {
+				if done.Load() {
+					return userID.Load().(string)
+				}
+				return resolveUserID()
+			})
+		if isProbe(c.Path()) {
+			builder = builder.Probe()
+		}
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+			builder = builder.
+				Str("trace_id", spanContext.TraceID().String()).
+				Str("span_id", spanContext.SpanID().String())
+		}
+		c.SetUserContext(builder.Logger().WithContext(ctx))
+
+		err := c.Next()
+
+		route.Store(resolveRoute())
+		userID.Store(resolveUserID())
+		done.Store(true)
+
+		return err
+	}
+}
+
+// pathMatcher reports whether a request path is one of the given paths, paths
+// ending in "*" match every path with that prefix
+func pathMatcher(paths []string) func(path string) bool {
+	exact := make(map[string]struct{}, len(paths))
+	var prefixes []string
+	for _, path := range paths {
+		if prefix, ok := strings.CutSuffix(path, "*"); ok {
+			prefixes = append(prefixes, prefix)
+			continue
+		}
+		exact[strings.TrimSuffix(path, "/")] = struct{}{}
+	}
+	return func(path string) bool {
+		if _, ok := exact[strings.TrimSuffix(path, "/")]; ok {
+			return true
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+}
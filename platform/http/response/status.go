@@ -0,0 +1,25 @@
+// Package response reads the outcome of a request from within Fiber middleware.
+// It is separate from platform/http so the metrics and tracing middlewares,
+// which platform/http imports, can use it.
+package response
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Status returns the status code the client receives for a request that returned
+// err from the rest of the middleware chain. The error handler runs after the
+// middleware chain, so the status is derived from the error: the code of a
+// *fiber.Error, 500 for any other error, and the response status otherwise.
+func Status(c *fiber.Ctx, err error) int {
+	if err == nil {
+		return c.Response().StatusCode()
+	}
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return fiberErr.Code
+	}
+	return fiber.StatusInternalServerError
+}
@@ -0,0 +1,37 @@
+package response
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		response int
+		err      error
+		want     int
+	}{
+		{name: "no error", response: fiber.StatusCreated, want: fiber.StatusCreated},
+		{name: "fiber error", response: fiber.StatusOK, err: fiber.ErrNotFound, want: fiber.StatusNotFound},
+		{name: "wrapped fiber error", response: fiber.StatusOK, err: fmt.Errorf("route: %w", fiber.ErrTooManyRequests), want: fiber.StatusTooManyRequests},
+		{name: "other error", response: fiber.StatusOK, err: errors.New("boom"), want: fiber.StatusInternalServerError},
+	}
+
+	app := fiber.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := app.AcquireCtx(&fasthttp.RequestCtx{})
+			defer app.ReleaseCtx(c)
+			c.Status(tt.response)
+
+			if got := Status(c, tt.err); got != tt.want {
+				t.Errorf("Status() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
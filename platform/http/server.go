@@ -1,31 +1,44 @@
 package http
 
 import (
-	"time"
+	"net"
 
+	"github.com/go-clean/platform/config"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/metrics"
+	"github.com/go-clean/platform/ratelimit"
+	"github.com/go-clean/platform/tracing"
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cors"
-	fiberLogger "github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
 )
 
 // Server represents the HTTP server configuration
 type Server struct {
-	app    *fiber.App
-	port   string
-	logger logger.Logger
+	app     *fiber.App
+	address string
+	prefork bool
+	logger  logger.Logger
 }
 
 // NewServer creates a new HTTP server with common middleware
-func NewServer(port string, log logger.Logger) *Server {
-	log.Info().Str("port", port).Msg("Initializing HTTP server")
+func NewServer(cfg config.ServerConfig, log logger.Logger, registry *metrics.Registry, tracer *tracing.Provider, logging config.LoggingConfig, corsConfig config.CORSConfig, rateLimit config.RateLimitConfig, limiter ratelimit.Store) *Server {
+	address := net.JoinHostPort(cfg.Host, cfg.Port)
+	log.Info().Str("address", address).Msg("Initializing HTTP server")
 
 	app := fiber.New(fiber.Config{
-		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		IdleTimeout:  30 * time.Second,
+		ReadTimeout:    cfg.ReadTimeout,
+		WriteTimeout:   cfg.WriteTimeout,
+		IdleTimeout:    cfg.IdleTimeout,
+		BodyLimit:      cfg.BodyLimit,
+		Concurrency:    cfg.Concurrency,
+		ReadBufferSize: cfg.MaxHeaderSize,
+		ProxyHeader:    cfg.ProxyHeader,
+		// Only honor the proxy header when sent by a trusted proxy, and only if it holds a valid IP
+		EnableTrustedProxyCheck: len(cfg.TrustedProxies) > 0,
+		TrustedProxies:          cfg.TrustedProxies,
+		EnableIPValidation:      cfg.ProxyHeader != "",
+		Prefork:                 cfg.Prefork,
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			return errorHandler(c, err, log)
 		},
@@ -35,20 +48,27 @@ func NewServer(port string, log logger.Logger) *Server {
 	log.Debug().Msg("Configuring HTTP server middleware")
 	app.Use(recover.New())
 	app.Use(requestid.New())
-	app.Use(fiberLogger.New(fiberLogger.Config{
-		Format: "${time} ${status} - ${method} ${path} ${latency}\n",
-	}))
-	app.Use(cors.New(cors.Config{
-		AllowOrigins: "*",
-		AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders: "Origin,Content-Type,Accept,Authorization",
-	}))
+	if tracer.Enabled() {
+		app.Use(tracer.Middleware())
+	}
+	app.Use(requestLogger(log, pathMatcher(logging.Probes.Paths)))
+	if logging.AccessLog.Enabled {
+		app.Use(accessLog(log, logging.AccessLog))
+	}
+	if registry.Enabled() {
+		app.Use(registry.Middleware())
+	}
+	app.Use(corsMiddleware(corsConfig))
+	if rateLimit.Enabled {
+		app.Use(rateLimiter(rateLimit, limiter, log))
+	}
 
 	log.Info().Msg("HTTP server initialized successfully")
 	return &Server{
-		app:    app,
-		port:   port,
-		logger: log,
+		app:     app,
+		address: address,
+		prefork: cfg.Prefork,
+		logger:  log,
 	}
 }
 
@@ -59,10 +79,10 @@ func (s *Server) GetApp() *fiber.App {
 
 // Start starts the HTTP server
 func (s *Server) Start() error {
-	s.logger.Info().Str("port", s.port).Msg("Starting HTTP server")
-	err := s.app.Listen(":" + s.port)
+	s.logger.Info().Str("address", s.address).Bool("prefork", s.prefork).Msg("Starting HTTP server")
+	err := s.app.Listen(s.address)
 	if err != nil {
-		s.logger.Error().Err(err).Str("port", s.port).Msg("Failed to start HTTP server")
+		s.logger.Error().Err(err).Str("address", s.address).Msg("Failed to start HTTP server")
 	}
 	return err
 }
@@ -87,7 +107,7 @@ func errorHandler(c *fiber.Ctx, err error, log logger.Logger) error {
 		code = e.Code
 	}
 
-	log.Error().Err(err).Int("status_code", code).Str("method", c.Method()).Str("path", c.Path()).Msg("HTTP request error")
+	log.FromContext(c.UserContext()).Error().Err(err).Int("status_code", code).Str("method", c.Method()).Str("path", c.Path()).Msg("HTTP request error")
 
 	return c.Status(code).JSON(fiber.Map{
 		"error": err.Error(),
@@ -0,0 +1,263 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// RootLoggerName identifies the root logger in level settings
+const RootLoggerName = ""
+
+// LevelSetting describes the level of a single logger
+type LevelSetting struct {
+	// Name is the logger name, RootLoggerName for the root logger
+	Name string
+	// Level is the level currently in effect for the logger
+	Level string
+	// ConfiguredLevel is the level in effect once temporary overrides expire,
+	// empty when a named logger inherits its level
+	ConfiguredLevel string
+	// ExpiresAt is when the temporary override ends, zero when there is none
+	ExpiresAt time.Time
+}
+
+// Temporary reports whether the level is a temporary override
+func (s LevelSetting) Temporary() bool {
+	return !s.ExpiresAt.IsZero()
+}
+
+// LevelController decides which events are logged, per logger name. A named logger
+// uses its own level when one is set, else that of its closest parent
+// ("probes.health" falls back to "probes"), else the root level. Levels can be
+// overridden temporarily and revert automatically once the override expires.
+type LevelController struct {
+	mu     sync.Mutex
+	state  atomic.Pointer[levelState]
+	timers map[string]*time.Timer
+	names  map[string]struct{}
+	// root is the configured root level that ResetLevel restores
+	root zerolog.Level
+}
+
+// levelState is an immutable snapshot of the levels, replaced on every change so
+// the logging hot path never takes a lock
+type levelState struct {
+	configured map[string]zerolog.Level
+	temporary  map[string]temporaryLevel
+}
+
+// temporaryLevel is a level override that ends at expiresAt
+type temporaryLevel struct {
+	level     zerolog.Level
+	expiresAt time.Time
+}
+
+// NewLevelController creates a level controller with the given root level
+func NewLevelController(root string) (*LevelController, error) {
+	level, err := parseLevel(root)
+	if err != nil {
+		return nil, err
+	}
+	return newLevelController(level), nil
+}
+
+func newLevelController(root zerolog.Level) *LevelController {
+	c := &LevelController{
+		timers: make(map[string]*time.Timer),
+		names:  make(map[string]struct{}),
+		root:   root,
+	}
+	c.state.Store(&levelState{
+		configured: map[string]zerolog.Level{RootLoggerName: root},
+		temporary:  map[string]temporaryLevel{},
+	})
+	return c
+}
+
+// enabled reports whether an event at level is logged by the named logger
+func (c *LevelController) enabled(name string, level zerolog.Level) bool {
+	return level >= c.state.Load().effective(name)
+}
+
+// effective resolves the level of a logger from its own, its parents' or the root setting
+func (s *levelState) effective(name string) zerolog.Level {
+	for {
+		if temporary, ok := s.temporary[name]; ok {
+			return temporary.level
+		}
+		if level, ok := s.configured[name]; ok {
+			return level
+		}
+		if name == RootLoggerName {
+			return zerolog.InfoLevel
+		}
+		if i := strings.LastIndexByte(name, '.'); i >= 0 {
+			name = name[:i]
+		} else {
+			name = RootLoggerName
+		}
+	}
+}
+
+// register records a logger name so it is listed by Settings
+func (c *LevelController) register(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.names[name] = struct{}{}
+}
+
+// SetLevel changes the level of the named logger. A positive ttl makes the change
+// temporary, the previous level is restored once it elapses.
+func (c *LevelController) SetLevel(name, level string, ttl time.Duration) (LevelSetting, error) {
+	logLevel, err := parseLevel(level)
+	if err != nil {
+		return LevelSetting{}, err
+	}
+	if ttl < 0 {
+		return LevelSetting{}, fmt.Errorf("level override ttl must not be negative, got %s", ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stopTimer(name)
+	c.update(func(s *levelState) {
+		if ttl > 0 {
+			s.temporary[name] = temporaryLevel{level: logLevel, expiresAt: time.Now().Add(ttl)}
+			return
+		}
+		delete(s.temporary, name)
+		s.configured[name] = logLevel
+	})
+	if ttl > 0 {
+		c.timers[name] = time.AfterFunc(ttl, func() { c.expire(name) })
+	}
+	return c.setting(c.state.Load(), name), nil
+}
+
+// ResetLevel removes any level set for the named logger so it inherits its parent's
+// level again, the root logger is reset to its configured level
+func (c *LevelController) ResetLevel(name string) LevelSetting {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stopTimer(name)
+	c.update(func(s *levelState) {
+		delete(s.temporary, name)
+		if name == RootLoggerName {
+			s.configured[name] = c.root
+			return
+		}
+		delete(s.configured, name)
+	})
+	return c.setting(c.state.Load(), name)
+}
+
+// setRoot sets the configured root level, keeping temporary overrides in place
+func (c *LevelController) setRoot(level zerolog.Level) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.root = level
+	c.update(func(s *levelState) {
+		s.configured[RootLoggerName] = level
+	})
+}
+
+// Setting returns the level setting of the named logger
+func (c *LevelController) Setting(name string) LevelSetting {
+	return c.setting(c.state.Load(), name)
+}
+
+// Settings returns the settings of the root logger followed by every named logger
+// that was created or has a level set, sorted by name
+func (c *LevelController) Settings() []LevelSetting {
+	c.mu.Lock()
+	state := c.state.Load()
+	names := make(map[string]struct{}, len(c.names)+len(state.configured))
+	for name := range c.names {
+		names[name] = struct{}{}
+	}
+	c.mu.Unlock()
+	for name := range state.configured {
+		names[name] = struct{}{}
+	}
+	for name := range state.temporary {
+		names[name] = struct{}{}
+	}
+	names[RootLoggerName] = struct{}{}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	settings := make([]LevelSetting, 0, len(sorted))
+	for _, name := range sorted {
+		settings = append(settings, c.setting(state, name))
+	}
+	return settings
+}
+
+// setting builds the level setting of a logger from a state snapshot
+func (c *LevelController) setting(s *levelState, name string) LevelSetting {
+	setting := LevelSetting{
+		Name:  name,
+		Level: s.effective(name).String(),
+	}
+	if level, ok := s.configured[name]; ok {
+		setting.ConfiguredLevel = level.String()
+	}
+	if temporary, ok := s.temporary[name]; ok {
+		setting.ExpiresAt = temporary.expiresAt
+	}
+	return setting
+}
+
+// expire ends the temporary override of a logger once its ttl elapsed
+func (c *LevelController) expire(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	temporary, ok := c.state.Load().temporary[name]
+	if !ok || time.Now().Before(temporary.expiresAt) {
+		// Replaced by a newer override, whose own timer ends it
+		return
+	}
+	delete(c.timers, name)
+	c.update(func(s *levelState) {
+		delete(s.temporary, name)
+	})
+}
+
+// stopTimer cancels the pending expiry of a logger's temporary override, must be called with mu held
+func (c *LevelController) stopTimer(name string) {
+	if timer, ok := c.timers[name]; ok {
+		timer.Stop()
+		delete(c.timers, name)
+	}
+}
+
+// update publishes a modified copy of the current state, must be called with mu held
+func (c *LevelController) update(modify func(s *levelState)) {
+	current := c.state.Load()
+	next := &levelState{
+		configured: make(map[string]zerolog.Level, len(current.configured)),
+		temporary:  make(map[string]temporaryLevel, len(current.temporary)),
+	}
+	for name, level := range current.configured {
+		next.configured[name] = level
+	}
+	for name, temporary := range current.temporary {
+		next.temporary[name] = temporary
+	}
+	modify(next)
+	c.state.Store(next)
+}
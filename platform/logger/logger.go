@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -10,11 +11,38 @@ import (
 // LogEvent represents a log event that can be chained with additional context
 type LogEvent interface {
 	Str(key, val string) LogEvent
+	Strs(key string, vals []string) LogEvent
 	Int(key string, i int) LogEvent
 	Int64(key string, i int64) LogEvent
+	Float64(key string, f float64) LogEvent
 	Bool(key string, b bool) LogEvent
+	// Dur adds a duration rendered in milliseconds
+	Dur(key string, d time.Duration) LogEvent
+	// Time adds a timestamp rendered in RFC 3339
+	Time(key string, t time.Time) LogEvent
+	// Any adds an arbitrary value rendered as JSON
+	Any(key string, val any) LogEvent
+	// Dict adds a nested object whose fields are added by build, the event passed
+	// to build must not be sent
+	Dict(key string, build func(dict LogEvent)) LogEvent
 	Err(err error) LogEvent
+	// Stack adds the stack trace of the logging call site when followed by Err
+	Stack() LogEvent
 	Msg(msg string)
+	Msgf(format string, args ...any)
+	// Send sends the event without a message
+	Send()
+}
+
+// LogContext builds a child logger that adds fields to every event
+type LogContext interface {
+	Str(key, val string) LogContext
+	// StrFunc adds a field evaluated when each event is logged, empty values are omitted
+	StrFunc(key string, fn func() string) LogContext
+	// Probe marks the logger as serving health probes, its events are sampled with
+	// the probe sampling options
+	Probe() LogContext
+	Logger() Logger
 }
 
 // Logger defines the interface for logging operations
@@ -24,85 +52,316 @@ type Logger interface {
 	Warn() LogEvent
 	Error() LogEvent
 	Fatal() LogEvent
+
+	// Named returns a child logger whose events carry its name and whose level can be
+	// controlled separately, names of nested loggers are joined with dots
+	Named(name string) Logger
+	// With starts building a child logger with additional fields
+	With() LogContext
+	// WithContext returns a copy of ctx carrying this logger
+	WithContext(ctx context.Context) context.Context
+	// FromContext returns the logger carried by ctx, or this logger if there is none
+	FromContext(ctx context.Context) Logger
+}
+
+// Controllable is implemented by loggers whose levels can be changed at runtime
+type Controllable interface {
+	Levels() *LevelController
 }
 
+// contextKey is the context key the request-scoped logger is stored under
+type contextKey struct{}
+
 // zerologEvent wraps zerolog.Event to implement LogEvent interface
 type zerologEvent struct {
-	event *zerolog.Event
+	event    *zerolog.Event
+	redactor *redactor
+	level    zerolog.Level
+	sampler  *sampler
 }
 
 func (e *zerologEvent) Str(key, val string) LogEvent {
-	return &zerologEvent{event: e.event.Str(key, val)}
+	return e.with(e.event.Str(key, e.redactor.field(key, val)))
+}
+
+func (e *zerologEvent) Strs(key string, vals []string) LogEvent {
+	redacted := make([]string, len(vals))
+	for i, val := range vals {
+		redacted[i] = e.redactor.field(key, val)
+	}
+	return e.with(e.event.Strs(key, redacted))
 }
 
 func (e *zerologEvent) Int(key string, i int) LogEvent {
-	return &zerologEvent{event: e.event.Int(key, i)}
+	return e.with(e.event.Int(key, i))
 }
 
 func (e *zerologEvent) Int64(key string, i int64) LogEvent {
-	return &zerologEvent{event: e.event.Int64(key, i)}
+	return e.with(e.event.Int64(key, i))
+}
+
+func (e *zerologEvent) Float64(key string, f float64) LogEvent {
+	return e.with(e.event.Float64(key, f))
 }
 
 func (e *zerologEvent) Bool(key string, b bool) LogEvent {
-	return &zerologEvent{event: e.event.Bool(key, b)}
+	return e.with(e.event.Bool(key, b))
+}
+
+func (e *zerologEvent) Dur(key string, d time.Duration) LogEvent {
+	return e.with(e.event.Dur(key, d))
+}
+
+func (e *zerologEvent) Time(key string, t time.Time) LogEvent {
+	return e.with(e.event.Time(key, t))
+}
+
+func (e *zerologEvent) Any(key string, val any) LogEvent {
+	if e.redactor.sensitive(key) {
+		return e.with(e.event.Str(key, Redacted))
+	}
+	if str, ok := val.(string); ok {
+		return e.with(e.event.Str(key, e.redactor.value(str)))
+	}
+	return e.with(e.event.Interface(key, val))
+}
+
+func (e *zerologEvent) Dict(key string, build func(dict LogEvent)) LogEvent {
+	dict := zerolog.Dict()
+	build(&zerologEvent{event: dict, redactor: e.redactor})
+	return e.with(e.event.Dict(key, dict))
 }
 
 func (e *zerologEvent) Err(err error) LogEvent {
-	return &zerologEvent{event: e.event.Err(err)}
+	return e.with(e.event.Err(e.redactor.error(err)))
+}
+
+func (e *zerologEvent) Stack() LogEvent {
+	return e.with(e.event.Stack())
+}
+
+// with returns an event sharing this event's redactor
+func (e *zerologEvent) with(event *zerolog.Event) LogEvent {
+	return &zerologEvent{event: event, redactor: e.redactor, level: e.level, sampler: e.sampler}
 }
 
 func (e *zerologEvent) Msg(msg string) {
-	e.event.Msg(msg)
+	if e.sample(msg) {
+		e.event.Msg(msg)
+	}
+}
+
+// Msgf events are sampled by their format, so all occurrences count together
+func (e *zerologEvent) Msgf(format string, args ...any) {
+	if e.sample(format) {
+		e.event.Msgf(format, args...)
+	}
+}
+
+func (e *zerologEvent) Send() {
+	if e.sample("") {
+		e.event.Send()
+	}
+}
+
+// sample reports whether the event is logged, dropping it otherwise. Logged events
+// carry the number of occurrences of the same message dropped since the last one.
+func (e *zerologEvent) sample(message string) bool {
+	if e.event == nil || e.sampler == nil {
+		return true
+	}
+	ok, suppressed := e.sampler.sample(e.level, message)
+	if !ok {
+		e.event.Discard()
+		// Sending a discarded event writes nothing and releases it
+		e.event.Send()
+		return false
+	}
+	if suppressed > 0 {
+		e.event.Int("suppressed", suppressed)
+	}
+	return true
 }
 
 // zerologLogger wraps zerolog.Logger to implement Logger interface
 type zerologLogger struct {
-	logger zerolog.Logger
+	logger    zerolog.Logger
+	sink      *sink
+	levels    *LevelController
+	redaction *redaction
+	sampling  *sampling
+	name      string
+	probe     bool
 }
 
 func (l *zerologLogger) Debug() LogEvent {
-	return &zerologEvent{event: l.logger.Debug()}
+	return l.event(zerolog.DebugLevel)
 }
 
 func (l *zerologLogger) Info() LogEvent {
-	return &zerologEvent{event: l.logger.Info()}
+	return l.event(zerolog.InfoLevel)
 }
 
 func (l *zerologLogger) Warn() LogEvent {
-	return &zerologEvent{event: l.logger.Warn()}
+	return l.event(zerolog.WarnLevel)
 }
 
 func (l *zerologLogger) Error() LogEvent {
-	return &zerologEvent{event: l.logger.Error()}
+	return l.event(zerolog.ErrorLevel)
 }
 
+// Fatal events are never filtered or sampled, sending one exits the process
 func (l *zerologLogger) Fatal() LogEvent {
-	return &zerologEvent{event: l.logger.Fatal()}
+	return l.named(l.logger.Fatal(), zerolog.FatalLevel, nil)
 }
 
-// New creates a new logger instance
+// event starts an event at level, or a no-op event when the level is disabled for this logger
+func (l *zerologLogger) event(level zerolog.Level) LogEvent {
+	if !l.levels.enabled(l.name, level) {
+		// Disabled events have no redactor so their fields are not scanned
+		return &zerologEvent{}
+	}
+	return l.named(l.logger.WithLevel(level), level, l.sampling.load(l.probe))
+}
+
+// named adds the logger name to an event
+func (l *zerologLogger) named(event *zerolog.Event, level zerolog.Level, sampler *sampler) LogEvent {
+	if l.name != "" {
+		event = event.Str("logger", l.name)
+	}
+	return &zerologEvent{event: event, redactor: l.redaction.load(), level: level, sampler: sampler}
+}
+
+func (l *zerologLogger) Named(name string) Logger {
+	if l.name != "" {
+		name = l.name + "." + name
+	}
+	l.levels.register(name)
+	named := *l
+	named.name = name
+	return &named
+}
+
+func (l *zerologLogger) Levels() *LevelController {
+	return l.levels
+}
+
+func (l *zerologLogger) With() LogContext {
+	return &zerologContext{
+		context:   l.logger.With(),
+		sink:      l.sink,
+		levels:    l.levels,
+		redaction: l.redaction,
+		sampling:  l.sampling,
+		name:      l.name,
+		probe:     l.probe,
+	}
+}
+
+func (l *zerologLogger) WithContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, Logger(l))
+}
+
+func (l *zerologLogger) FromContext(ctx context.Context) Logger {
+	if ctx == nil {
+		return l
+	}
+	logger, ok := ctx.Value(contextKey{}).(Logger)
+	if !ok {
+		return l
+	}
+	// Keep the fields of the context logger under this logger's name and level
+	if scoped, ok := logger.(*zerologLogger); ok && scoped.name != l.name {
+		renamed := *scoped
+		renamed.name = l.name
+		return &renamed
+	}
+	return logger
+}
+
+// zerologContext wraps zerolog.Context to implement LogContext interface
+type zerologContext struct {
+	context   zerolog.Context
+	hooks     []zerolog.Hook
+	sink      *sink
+	levels    *LevelController
+	redaction *redaction
+	sampling  *sampling
+	name      string
+	probe     bool
+}
+
+func (c *zerologContext) Str(key, val string) LogContext {
+	c.context = c.context.Str(key, c.redaction.load().field(key, val))
+	return c
+}
+
+func (c *zerologContext) StrFunc(key string, fn func() string) LogContext {
+	c.hooks = append(c.hooks, zerolog.HookFunc(func(e *zerolog.Event, _ zerolog.Level, _ string) {
+		if val := fn(); val != "" {
+			e.Str(key, c.redaction.load().field(key, val))
+		}
+	}))
+	return c
+}
+
+func (c *zerologContext) Probe() LogContext {
+	c.probe = true
+	return c
+}
+
+func (c *zerologContext) Logger() Logger {
+	logger := c.context.Logger()
+	for _, hook := range c.hooks {
+		logger = logger.Hook(hook)
+	}
+	return &zerologLogger{
+		logger:    logger,
+		sink:      c.sink,
+		levels:    c.levels,
+		redaction: c.redaction,
+		sampling:  c.sampling,
+		name:      c.name,
+		probe:     c.probe,
+	}
+}
+
+// New creates a bootstrap logger writing JSON entries at info level to stdout,
+// call Configure once the logging configuration is loaded
 func New() Logger {
+	return newLogger()
+}
+
+// newLogger creates a root logger whose destination can be swapped later
+func newLogger() *zerologLogger {
 	// Configure zerolog
 	zerolog.TimeFieldFormat = time.RFC3339
+	zerolog.DurationFieldUnit = time.Millisecond
+	zerolog.ErrorStackMarshaler = marshalStack
 
-	// Create logger with console writer for development
-	consoleWriter := zerolog.ConsoleWriter{
-		Out:        os.Stdout,
-		TimeFormat: time.RFC3339,
-	}
+	// Write JSON to stdout until the configured output is known, so entries logged
+	// while bootstrapping are parsed like any other
+	out := &sink{writer: os.Stdout}
 
-	logger := zerolog.New(consoleWriter).With().Timestamp().Logger()
+	logger := zerolog.New(out).With().Timestamp().Logger()
 
-	return &zerologLogger{logger: logger}
+	return &zerologLogger{
+		logger:    logger,
+		sink:      out,
+		levels:    newLevelController(zerolog.InfoLevel),
+		redaction: newRedaction(),
+		sampling:  &sampling{},
+	}
 }
 
 // NewWithLevel creates a new logger with specified level
 func NewWithLevel(level string) Logger {
-	logLevel, err := zerolog.ParseLevel(level)
+	logLevel, err := parseLevel(level)
 	if err != nil {
 		logLevel = zerolog.InfoLevel
 	}
 
-	zerolog.SetGlobalLevel(logLevel)
-	return New()
+	l := newLogger()
+	l.levels.setRoot(logLevel)
+	return l
 }
@@ -0,0 +1,184 @@
+package logtest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TB is the subset of testing.TB the assertion helpers need
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// Matcher selects recorded entries
+type Matcher struct {
+	description string
+	match       func(Entry) bool
+}
+
+func (m Matcher) String() string {
+	return m.description
+}
+
+// Level matches entries logged at level
+func Level(level string) Matcher {
+	return Matcher{
+		description: "level " + level,
+		match:       func(e Entry) bool { return e.Level == level },
+	}
+}
+
+// Message matches entries with exactly the given message
+func Message(msg string) Matcher {
+	return Matcher{
+		description: fmt.Sprintf("message %q", msg),
+		match:       func(e Entry) bool { return e.Message == msg },
+	}
+}
+
+// MessageContains matches entries whose message contains substr
+func MessageContains(substr string) Matcher {
+	return Matcher{
+		description: fmt.Sprintf("message containing %q", substr),
+		match:       func(e Entry) bool { return strings.Contains(e.Message, substr) },
+	}
+}
+
+// LoggerName matches entries sent through the logger with the given name
+func LoggerName(name string) Matcher {
+	return Matcher{
+		description: "logger " + name,
+		match:       func(e Entry) bool { return e.Logger == name },
+	}
+}
+
+// HasField matches entries carrying a field with the given key
+func HasField(key string) Matcher {
+	return Matcher{
+		description: "field " + key,
+		match: func(e Entry) bool {
+			_, ok := e.Fields[key]
+			return ok
+		},
+	}
+}
+
+// Field matches entries carrying a field with the given key and value. Integer
+// values match regardless of their type, so Field("status", 500) matches Int64 fields.
+func Field(key string, val any) Matcher {
+	return Matcher{
+		description: fmt.Sprintf("field %s=%v", key, val),
+		match: func(e Entry) bool {
+			actual, ok := e.Fields[key]
+			return ok && equal(actual, val)
+		},
+	}
+}
+
+// Err matches entries whose error is target or wraps it, a nil target matches any error
+func Err(target error) Matcher {
+	description := "any error"
+	if target != nil {
+		description = fmt.Sprintf("error %q", target.Error())
+	}
+	return Matcher{
+		description: description,
+		match: func(e Entry) bool {
+			if target == nil {
+				return e.Err != nil
+			}
+			return errors.Is(e.Err, target)
+		},
+	}
+}
+
+// equal compares field values, integers of different types are compared by value
+func equal(actual, expected any) bool {
+	a, b := reflect.ValueOf(actual), reflect.ValueOf(expected)
+	if isInt(a) && isInt(b) {
+		return a.Int() == b.Int()
+	}
+	return reflect.DeepEqual(actual, expected)
+}
+
+func isInt(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// Find returns the recorded entries matching every matcher, in order
+func (l *Logger) Find(matchers ...Matcher) []Entry {
+	var found []Entry
+	for _, entry := range l.Entries() {
+		if matchAll(entry, matchers) {
+			found = append(found, entry)
+		}
+	}
+	return found
+}
+
+func matchAll(entry Entry, matchers []Matcher) bool {
+	for _, matcher := range matchers {
+		if !matcher.match(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+// AssertLogged fails the test unless an entry matching every matcher was recorded,
+// and returns the first such entry
+func (l *Logger) AssertLogged(t TB, matchers ...Matcher) Entry {
+	t.Helper()
+	found := l.Find(matchers...)
+	if len(found) == 0 {
+		t.Errorf("no log entry with %s, recorded:\n%s", describe(matchers), l.dump())
+		return Entry{}
+	}
+	return found[0]
+}
+
+// AssertNotLogged fails the test if an entry matching every matcher was recorded
+func (l *Logger) AssertNotLogged(t TB, matchers ...Matcher) {
+	t.Helper()
+	if found := l.Find(matchers...); len(found) > 0 {
+		t.Errorf("unexpected log entry with %s: %s", describe(matchers), found[0])
+	}
+}
+
+// AssertError fails the test unless an error entry with the given field key and
+// value was recorded, and returns the first such entry
+func (l *Logger) AssertError(t TB, key string, val any) Entry {
+	t.Helper()
+	return l.AssertLogged(t, Level(LevelError), Field(key, val))
+}
+
+func describe(matchers []Matcher) string {
+	if len(matchers) == 0 {
+		return "anything"
+	}
+	descriptions := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = matcher.String()
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// dump renders every recorded entry, one per line
+func (l *Logger) dump() string {
+	entries := l.Entries()
+	if len(entries) == 0 {
+		return "  (none)"
+	}
+	lines := make([]string, len(entries))
+	for i, entry := range entries {
+		lines[i] = "  " + entry.String()
+	}
+	return strings.Join(lines, "\n")
+}
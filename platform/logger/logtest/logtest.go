@@ -0,0 +1,320 @@
+// Package logtest provides logger.Logger implementations for tests: an in-memory
+// Logger recording every event with assertion helpers, and a no-op logger.
+package logtest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-clean/platform/logger"
+)
+
+// Levels recorded in Entry.Level
+const (
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelWarn  = "warn"
+	LevelError = "error"
+	LevelFatal = "fatal"
+)
+
+// Entry is a recorded log event
+type Entry struct {
+	Level   string
+	Message string
+	// Logger is the name of the logger the event was sent through, empty for the root logger
+	Logger string
+	// Fields holds the event and context fields with their Go values, Dict fields are
+	// map[string]any and Dur fields time.Duration
+	Fields map[string]any
+	// Err is the error added with Err, if any
+	Err error
+	// Stack reports whether a stack trace was requested
+	Stack bool
+	// Probe reports whether the logger was marked with Probe
+	Probe bool
+}
+
+// String renders the entry for failure messages
+func (e Entry) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q", e.Level, e.Message)
+	if e.Logger != "" {
+		fmt.Fprintf(&b, " logger=%s", e.Logger)
+	}
+	keys := make([]string, 0, len(e.Fields))
+	for key := range e.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, " %s=%v", key, e.Fields[key])
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, " error=%q", e.Err.Error())
+	}
+	return b.String()
+}
+
+// recorder stores the entries of a root Logger and every logger derived from it
+type recorder struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+func (r *recorder) record(entry Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entry)
+}
+
+// field is a context field added with LogContext.Str
+type field struct {
+	key string
+	val string
+}
+
+// fieldFunc is a context field added with LogContext.StrFunc
+type fieldFunc struct {
+	key string
+	fn  func() string
+}
+
+// contextKey is the context key the logger is stored under by WithContext
+type contextKey struct{}
+
+// Logger is an in-memory logger.Logger recording events of every level. Loggers
+// derived through Named, With and FromContext record into the same entries.
+// Fatal events are recorded like any other event and do not exit the process.
+type Logger struct {
+	recorder *recorder
+	name     string
+	fields   []field
+	funcs    []fieldFunc
+	probe    bool
+}
+
+var _ logger.Logger = (*Logger)(nil)
+
+// New creates an empty in-memory logger
+func New() *Logger {
+	return &Logger{recorder: &recorder{}}
+}
+
+func (l *Logger) Debug() logger.LogEvent {
+	return l.event(LevelDebug)
+}
+
+func (l *Logger) Info() logger.LogEvent {
+	return l.event(LevelInfo)
+}
+
+func (l *Logger) Warn() logger.LogEvent {
+	return l.event(LevelWarn)
+}
+
+func (l *Logger) Error() logger.LogEvent {
+	return l.event(LevelError)
+}
+
+func (l *Logger) Fatal() logger.LogEvent {
+	return l.event(LevelFatal)
+}
+
+// event starts an event at level carrying the context fields of this logger
+func (l *Logger) event(level string) logger.LogEvent {
+	return &event{
+		logger: l,
+		entry: Entry{
+			Level:  level,
+			Logger: l.name,
+			Fields: make(map[string]any),
+			Probe:  l.probe,
+		},
+	}
+}
+
+func (l *Logger) Named(name string) logger.Logger {
+	if l.name != "" {
+		name = l.name + "." + name
+	}
+	named := l.clone()
+	named.name = name
+	return named
+}
+
+func (l *Logger) With() logger.LogContext {
+	return &logContext{logger: l.clone()}
+}
+
+func (l *Logger) WithContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger.Logger(l))
+}
+
+func (l *Logger) FromContext(ctx context.Context) logger.Logger {
+	if ctx == nil {
+		return l
+	}
+	scoped, ok := ctx.Value(contextKey{}).(logger.Logger)
+	if !ok {
+		return l
+	}
+	// Keep the fields of the context logger under this logger's name, as the real logger does
+	if recording, ok := scoped.(*Logger); ok && recording.name != l.name {
+		renamed := recording.clone()
+		renamed.name = l.name
+		return renamed
+	}
+	return scoped
+}
+
+// clone copies the logger so derived loggers do not share field slices
+func (l *Logger) clone() *Logger {
+	return &Logger{
+		recorder: l.recorder,
+		name:     l.name,
+		fields:   append([]field(nil), l.fields...),
+		funcs:    append([]fieldFunc(nil), l.funcs...),
+		probe:    l.probe,
+	}
+}
+
+// Entries returns a copy of every entry recorded so far, in order
+func (l *Logger) Entries() []Entry {
+	l.recorder.mu.Lock()
+	defer l.recorder.mu.Unlock()
+	return append([]Entry(nil), l.recorder.entries...)
+}
+
+// Reset discards the recorded entries
+func (l *Logger) Reset() {
+	l.recorder.mu.Lock()
+	defer l.recorder.mu.Unlock()
+	l.recorder.entries = nil
+}
+
+// logContext builds a child Logger
+type logContext struct {
+	logger *Logger
+}
+
+func (c *logContext) Str(key, val string) logger.LogContext {
+	c.logger.fields = append(c.logger.fields, field{key: key, val: val})
+	return c
+}
+
+func (c *logContext) StrFunc(key string, fn func() string) logger.LogContext {
+	c.logger.funcs = append(c.logger.funcs, fieldFunc{key: key, fn: fn})
+	return c
+}
+
+func (c *logContext) Probe() logger.LogContext {
+	c.logger.probe = true
+	return c
+}
+
+func (c *logContext) Logger() logger.Logger {
+	return c.logger.clone()
+}
+
+// event records its fields into an entry, events without a logger are Dict builders
+type event struct {
+	logger *Logger
+	entry  Entry
+}
+
+func (e *event) set(key string, val any) logger.LogEvent {
+	e.entry.Fields[key] = val
+	return e
+}
+
+func (e *event) Str(key, val string) logger.LogEvent {
+	return e.set(key, val)
+}
+
+func (e *event) Strs(key string, vals []string) logger.LogEvent {
+	return e.set(key, append([]string(nil), vals...))
+}
+
+func (e *event) Int(key string, i int) logger.LogEvent {
+	return e.set(key, i)
+}
+
+func (e *event) Int64(key string, i int64) logger.LogEvent {
+	return e.set(key, i)
+}
+
+func (e *event) Float64(key string, f float64) logger.LogEvent {
+	return e.set(key, f)
+}
+
+func (e *event) Bool(key string, b bool) logger.LogEvent {
+	return e.set(key, b)
+}
+
+func (e *event) Dur(key string, d time.Duration) logger.LogEvent {
+	return e.set(key, d)
+}
+
+func (e *event) Time(key string, t time.Time) logger.LogEvent {
+	return e.set(key, t)
+}
+
+func (e *event) Any(key string, val any) logger.LogEvent {
+	return e.set(key, val)
+}
+
+func (e *event) Dict(key string, build func(dict logger.LogEvent)) logger.LogEvent {
+	dict := &event{entry: Entry{Fields: make(map[string]any)}}
+	build(dict)
+	return e.set(key, dict.entry.Fields)
+}
+
+func (e *event) Err(err error) logger.LogEvent {
+	e.entry.Err = err
+	return e
+}
+
+func (e *event) Stack() logger.LogEvent {
+	e.entry.Stack = true
+	return e
+}
+
+func (e *event) Msg(msg string) {
+	e.entry.Message = msg
+	e.send()
+}
+
+func (e *event) Msgf(format string, args ...any) {
+	e.entry.Message = fmt.Sprintf(format, args...)
+	e.send()
+}
+
+func (e *event) Send() {
+	e.send()
+}
+
+// send records the entry, event fields take precedence over context fields
+func (e *event) send() {
+	if e.logger == nil {
+		return
+	}
+	for _, f := range e.logger.fields {
+		if _, ok := e.entry.Fields[f.key]; !ok {
+			e.entry.Fields[f.key] = f.val
+		}
+	}
+	for _, f := range e.logger.funcs {
+		if _, ok := e.entry.Fields[f.key]; ok {
+			continue
+		}
+		if val := f.fn(); val != "" {
+			e.entry.Fields[f.key] = val
+		}
+	}
+	e.logger.recorder.record(e.entry)
+}
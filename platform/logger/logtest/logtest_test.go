@@ -0,0 +1,210 @@
+package logtest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/logger/logtest"
+)
+
+// fakeTB records assertion failures instead of failing the test
+type fakeTB struct {
+	failures []string
+}
+
+func (t *fakeTB) Helper() {}
+
+func (t *fakeTB) Errorf(format string, args ...any) {
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+}
+
+func TestLoggerPropagatesNameAndFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		log        func(root *logtest.Logger)
+		wantLogger string
+		wantFields map[string]any
+	}{
+		{
+			name:       "root",
+			log:        func(root *logtest.Logger) { root.Info().Str("key", "value").Msg("event") },
+			wantFields: map[string]any{"key": "value"},
+		},
+		{
+			name:       "named twice",
+			log:        func(root *logtest.Logger) { root.Named("probes").Named("health").Info().Msg("event") },
+			wantLogger: "probes.health",
+			wantFields: map[string]any{},
+		},
+		{
+			name: "with fields",
+			log: func(root *logtest.Logger) {
+				root.With().Str("request_id", "abc").Logger().Named("http").Info().Int("status", 200).Msg("event")
+			},
+			wantLogger: "http",
+			wantFields: map[string]any{"request_id": "abc", "status": 200},
+		},
+		{
+			name: "event field overrides context field",
+			log: func(root *logtest.Logger) {
+				root.With().Str("key", "context").Logger().Info().Str("key", "event").Msg("event")
+			},
+			wantFields: map[string]any{"key": "event"},
+		},
+		{
+			name: "from context keeps fields under the receiver name",
+			log: func(root *logtest.Logger) {
+				scoped := root.With().Str("request_id", "abc").Logger()
+				ctx := scoped.WithContext(context.Background())
+				root.Named("admin").FromContext(ctx).Info().Msg("event")
+			},
+			wantLogger: "admin",
+			wantFields: map[string]any{"request_id": "abc"},
+		},
+		{
+			name: "from context without logger",
+			log: func(root *logtest.Logger) {
+				root.Named("admin").FromContext(context.Background()).Info().Msg("event")
+			},
+			wantLogger: "admin",
+			wantFields: map[string]any{},
+		},
+		{
+			name: "str func evaluated when sent",
+			log: func(root *logtest.Logger) {
+				value := "before"
+				child := root.With().StrFunc("route", func() string { return value }).Logger()
+				value = "after"
+				child.Info().Msg("event")
+			},
+			wantFields: map[string]any{"route": "after"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := logtest.New()
+			tt.log(root)
+
+			entries := root.Entries()
+			if len(entries) != 1 {
+				t.Fatalf("recorded %d entries, want 1", len(entries))
+			}
+			entry := entries[0]
+			if entry.Logger != tt.wantLogger {
+				t.Errorf("logger = %q, want %q", entry.Logger, tt.wantLogger)
+			}
+			if len(entry.Fields) != len(tt.wantFields) {
+				t.Errorf("fields = %v, want %v", entry.Fields, tt.wantFields)
+			}
+			for key, want := range tt.wantFields {
+				if got := entry.Fields[key]; got != want {
+					t.Errorf("field %s = %v, want %v", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDerivedLoggersShareEntries(t *testing.T) {
+	root := logtest.New()
+	root.Named("a").Info().Msg("first")
+	root.With().Str("key", "value").Logger().Warn().Msg("second")
+
+	if got := len(root.Entries()); got != 2 {
+		t.Fatalf("recorded %d entries, want 2", got)
+	}
+	root.Reset()
+	if got := len(root.Entries()); got != 0 {
+		t.Fatalf("recorded %d entries after Reset, want 0", got)
+	}
+}
+
+func TestAssertLogged(t *testing.T) {
+	errDown := errors.New("database down")
+
+	tests := []struct {
+		name        string
+		matchers    []logtest.Matcher
+		wantFailure string
+	}{
+		{
+			name:     "matching entry",
+			matchers: []logtest.Matcher{logtest.Level(logtest.LevelError), logtest.Message("Health check failed")},
+		},
+		{
+			name:     "integer fields match across types",
+			matchers: []logtest.Matcher{logtest.Field("status", 503)},
+		},
+		{
+			name:     "wrapped error",
+			matchers: []logtest.Matcher{logtest.Err(errDown), logtest.LoggerName("probes")},
+		},
+		{
+			name:        "wrong level",
+			matchers:    []logtest.Matcher{logtest.Level(logtest.LevelWarn), logtest.MessageContains("failed")},
+			wantFailure: `no log entry with level warn, message containing "failed"`,
+		},
+		{
+			name:        "missing field",
+			matchers:    []logtest.Matcher{logtest.HasField("checker")},
+			wantFailure: "no log entry with field checker",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := logtest.New()
+			root.Named("probes").Error().Int64("status", 503).Err(fmt.Errorf("check: %w", errDown)).Msg("Health check failed")
+
+			tb := &fakeTB{}
+			entry := root.AssertLogged(tb, tt.matchers...)
+
+			if tt.wantFailure == "" {
+				if len(tb.failures) != 0 {
+					t.Fatalf("unexpected failures: %v", tb.failures)
+				}
+				if entry.Message != "Health check failed" {
+					t.Errorf("returned entry %v, want the recorded one", entry)
+				}
+				return
+			}
+			if len(tb.failures) != 1 {
+				t.Fatalf("recorded %d failures, want 1", len(tb.failures))
+			}
+			if !strings.Contains(tb.failures[0], tt.wantFailure) {
+				t.Errorf("failure %q does not contain %q", tb.failures[0], tt.wantFailure)
+			}
+			// The recorded entries are listed to help debugging
+			if !strings.Contains(tb.failures[0], `error "Health check failed" logger=probes status=503`) {
+				t.Errorf("failure %q does not list the recorded entries", tb.failures[0])
+			}
+		})
+	}
+}
+
+func TestAssertNotLogged(t *testing.T) {
+	root := logtest.New()
+	root.Info().Msg("Health check passed")
+
+	tb := &fakeTB{}
+	root.AssertNotLogged(tb, logtest.Level(logtest.LevelError))
+	if len(tb.failures) != 0 {
+		t.Fatalf("unexpected failures: %v", tb.failures)
+	}
+
+	root.AssertNotLogged(tb, logtest.Message("Health check passed"))
+	if len(tb.failures) != 1 {
+		t.Fatalf("recorded %d failures, want 1", len(tb.failures))
+	}
+}
+
+func TestNopDiscards(t *testing.T) {
+	var log logger.Logger = logtest.Nop()
+	ctx := log.WithContext(context.Background())
+	log.FromContext(ctx).Named("x").With().Str("key", "value").Logger().Error().Err(errors.New("ignored")).Msg("event")
+}
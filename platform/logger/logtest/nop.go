@@ -0,0 +1,54 @@
+package logtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-clean/platform/logger"
+)
+
+// Nop returns a logger.Logger that discards every event
+func Nop() logger.Logger {
+	return nopLogger{}
+}
+
+type nopLogger struct{}
+
+var _ logger.Logger = nopLogger{}
+
+func (nopLogger) Debug() logger.LogEvent { return nopEvent{} }
+func (nopLogger) Info() logger.LogEvent  { return nopEvent{} }
+func (nopLogger) Warn() logger.LogEvent  { return nopEvent{} }
+func (nopLogger) Error() logger.LogEvent { return nopEvent{} }
+func (nopLogger) Fatal() logger.LogEvent { return nopEvent{} }
+
+func (l nopLogger) Named(string) logger.Logger { return l }
+func (nopLogger) With() logger.LogContext      { return nopContext{} }
+
+func (nopLogger) WithContext(ctx context.Context) context.Context { return ctx }
+func (l nopLogger) FromContext(context.Context) logger.Logger     { return l }
+
+type nopContext struct{}
+
+func (c nopContext) Str(string, string) logger.LogContext            { return c }
+func (c nopContext) StrFunc(string, func() string) logger.LogContext { return c }
+func (c nopContext) Probe() logger.LogContext                        { return c }
+func (nopContext) Logger() logger.Logger                             { return nopLogger{} }
+
+type nopEvent struct{}
+
+func (e nopEvent) Str(string, string) logger.LogEvent                      { return e }
+func (e nopEvent) Strs(string, []string) logger.LogEvent                   { return e }
+func (e nopEvent) Int(string, int) logger.LogEvent                         { return e }
+func (e nopEvent) Int64(string, int64) logger.LogEvent                     { return e }
+func (e nopEvent) Float64(string, float64) logger.LogEvent                 { return e }
+func (e nopEvent) Bool(string, bool) logger.LogEvent                       { return e }
+func (e nopEvent) Dur(string, time.Duration) logger.LogEvent               { return e }
+func (e nopEvent) Time(string, time.Time) logger.LogEvent                  { return e }
+func (e nopEvent) Any(string, any) logger.LogEvent                         { return e }
+func (e nopEvent) Dict(string, func(dict logger.LogEvent)) logger.LogEvent { return e }
+func (e nopEvent) Err(error) logger.LogEvent                               { return e }
+func (e nopEvent) Stack() logger.LogEvent                                  { return e }
+func (nopEvent) Msg(string)                                                {}
+func (nopEvent) Msgf(string, ...any)                                       {}
+func (nopEvent) Send()                                                     {}
@@ -0,0 +1,162 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Supported log formats
+const (
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+// Supported log outputs, any other value is treated as a file path
+const (
+	OutputStdout = "stdout"
+	OutputStderr = "stderr"
+)
+
+// Options describes how log entries are rendered and where they are written
+type Options struct {
+	// Level is the minimum level logged: debug, info, warn, error or fatal
+	Level string
+	// Format is either json or console
+	Format string
+	// Output is stdout, stderr or the path of a file entries are appended to
+	Output string
+	// RedactKeys are the field keys whose values are masked, DefaultRedactKeys when empty
+	RedactKeys []string
+	// RedactPatterns are additional regular expressions masked in every string value
+	RedactPatterns []string
+	// Sampling samples repeated events per level, nothing is sampled when empty
+	Sampling SamplingOptions
+	// ProbeMode is how events of loggers marked with Probe are reduced: full, sample or suppress
+	ProbeMode string
+	// ProbeSampling overrides Sampling for loggers marked with Probe in sample mode
+	ProbeSampling SamplingOptions
+}
+
+// Reconfigurable is implemented by loggers whose level, format and output can be
+// changed after construction; the change applies to every logger derived from it
+type Reconfigurable interface {
+	Configure(opts Options) error
+}
+
+// sink is the writer shared by a root logger and all loggers derived from it,
+// so the destination can be swapped once configuration is known
+type sink struct {
+	mu     sync.RWMutex
+	writer io.Writer
+	closer io.Closer
+}
+
+func (s *sink) Write(p []byte) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.writer.Write(p)
+}
+
+// swap replaces the destination, closing the previous one if it was a file
+func (s *sink) swap(writer io.Writer, closer io.Closer) error {
+	s.mu.Lock()
+	previous := s.closer
+	s.writer, s.closer = writer, closer
+	s.mu.Unlock()
+
+	if previous != nil {
+		return previous.Close()
+	}
+	return nil
+}
+
+// buildWriter opens the output and wraps it in the requested format
+func buildWriter(opts Options) (io.Writer, io.Closer, error) {
+	var (
+		out    io.Writer
+		closer io.Closer
+		isFile bool
+	)
+	switch output := strings.TrimSpace(opts.Output); strings.ToLower(output) {
+	case "", OutputStdout:
+		out = os.Stdout
+	case OutputStderr:
+		out = os.Stderr
+	default:
+		if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+			return nil, nil, fmt.Errorf("failed to create log directory: %w", err)
+		}
+		file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		out, closer, isFile = file, file, true
+	}
+
+	switch strings.ToLower(strings.TrimSpace(opts.Format)) {
+	case "", FormatJSON:
+		return out, closer, nil
+	case FormatConsole:
+		return zerolog.ConsoleWriter{Out: out, TimeFormat: time.RFC3339, NoColor: isFile}, closer, nil
+	default:
+		if closer != nil {
+			closer.Close()
+		}
+		return nil, nil, fmt.Errorf("unsupported log format %q, expected %s or %s", opts.Format, FormatJSON, FormatConsole)
+	}
+}
+
+// parseLevel converts a level name into a zerolog level, defaulting to info when empty
+func parseLevel(level string) (zerolog.Level, error) {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if level == "" {
+		return zerolog.InfoLevel, nil
+	}
+	logLevel, err := zerolog.ParseLevel(level)
+	if err != nil || logLevel < zerolog.TraceLevel || logLevel > zerolog.FatalLevel {
+		return zerolog.NoLevel, fmt.Errorf("unsupported log level %q, expected trace, debug, info, warn, error or fatal", level)
+	}
+	return logLevel, nil
+}
+
+// Configure applies the options to this logger and every logger derived from it
+func (l *zerologLogger) Configure(opts Options) error {
+	logLevel, err := parseLevel(opts.Level)
+	if err != nil {
+		return err
+	}
+	redactor, err := newRedactor(opts.RedactKeys, opts.RedactPatterns)
+	if err != nil {
+		return err
+	}
+	writer, closer, err := buildWriter(opts)
+	if err != nil {
+		return err
+	}
+	if err := l.sampling.store(opts.Sampling, opts.ProbeMode, opts.ProbeSampling); err != nil {
+		if closer != nil {
+			closer.Close()
+		}
+		return err
+	}
+
+	l.levels.setRoot(logLevel)
+	l.redaction.current.Store(redactor)
+	return l.sink.swap(writer, closer)
+}
+
+// NewWithOptions creates a new logger rendering and writing entries as described by opts
+func NewWithOptions(opts Options) (Logger, error) {
+	l := newLogger()
+	if err := l.Configure(opts); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
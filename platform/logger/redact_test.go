@@ -0,0 +1,143 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestRedactorValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		value    string
+		want     string
+	}{
+		{name: "plain message", value: "Health check passed", want: "Health check passed"},
+		{name: "URL credentials", value: "dial postgres://app:s3cret@db:5432/app", want: "dial postgres://app:" + Redacted + "@db:5432/app"},
+		{name: "URL password without username", value: "dial redis://:s3cret@cache:6379", want: "dial redis://:" + Redacted + "@cache:6379"},
+		{name: "URL without credentials", value: "GET https://example.com/path", want: "GET https://example.com/path"},
+		{name: "bearer token", value: "Authorization: Bearer abc.def-ghi", want: "Authorization: " + Redacted + " " + Redacted},
+		{name: "basic credentials in any case", value: "sent BASIC dXNlcjpwYXNz", want: "sent BASIC " + Redacted},
+		{name: "email address", value: "user jane.doe@example.com signed in", want: "user " + Redacted + " signed in"},
+		{name: "key value pair", value: "connect host=db password=s3cret sslmode=disable", want: "connect host=db password=" + Redacted + " sslmode=disable"},
+		{name: "query string", value: "/callback?api-key=xyz&page=2", want: "/callback?api-key=" + Redacted + "&page=2"},
+		{name: "quoted value", value: `{"client_secret": "a b c"}`, want: `{"client_secret": ` + Redacted + `}`},
+		{name: "custom pattern", patterns: []string{`\b\d{16}\b`}, value: "card 4111111111111111 declined", want: "card " + Redacted + " declined"},
+		{name: "empty", value: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newRedactor(nil, tt.patterns)
+			if err != nil {
+				t.Fatalf("newRedactor failed: %v", err)
+			}
+			if got := r.value(tt.value); got != tt.want {
+				t.Errorf("value(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactorField(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		key  string
+		val  string
+		want string
+	}{
+		{name: "sensitive key", key: "password", val: "s3cret", want: Redacted},
+		{name: "key containing a sensitive key", key: "X-Api-Key", val: "abc", want: Redacted},
+		{name: "empty sensitive value stays visible", key: "token", val: "", want: ""},
+		{name: "regular key", key: "path", val: "/health", want: "/health"},
+		{name: "regular key with sensitive content", key: "dsn", val: "redis://:pw@cache:6379", want: "redis://:" + Redacted + "@cache:6379"},
+		{name: "configured keys replace the defaults", keys: []string{"ssn"}, key: "password", val: "s3cret", want: "s3cret"},
+		{name: "configured key", keys: []string{"ssn"}, key: "user_ssn", val: "123", want: Redacted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newRedactor(tt.keys, nil)
+			if err != nil {
+				t.Fatalf("newRedactor failed: %v", err)
+			}
+			if got := r.field(tt.key, tt.val); got != tt.want {
+				t.Errorf("field(%q, %q) = %q, want %q", tt.key, tt.val, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactorError(t *testing.T) {
+	r, err := newRedactor(nil, nil)
+	if err != nil {
+		t.Fatalf("newRedactor failed: %v", err)
+	}
+	errTimeout := errors.New("i/o timeout")
+	var nilRedactor *redactor
+
+	tests := []struct {
+		name     string
+		redactor *redactor
+		err      error
+		wantMsg  string
+		wantSame bool
+	}{
+		{name: "nil error", redactor: r, err: nil},
+		{name: "nothing to mask", redactor: r, err: errTimeout, wantMsg: "i/o timeout", wantSame: true},
+		{name: "masked message", redactor: r, err: fmt.Errorf("dial postgres://app:pw@db: %w", errTimeout), wantMsg: "dial postgres://app:" + Redacted + "@db: i/o timeout"},
+		{name: "nil redactor keeps the error", redactor: nilRedactor, err: errTimeout, wantMsg: "i/o timeout", wantSame: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.redactor.error(tt.err)
+			if tt.err == nil {
+				if got != nil {
+					t.Fatalf("error(nil) = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("error() = nil, want an error")
+			}
+			if got.Error() != tt.wantMsg {
+				t.Errorf("message = %q, want %q", got.Error(), tt.wantMsg)
+			}
+			if tt.wantSame && got != tt.err {
+				t.Errorf("error() = %#v, want the original error", got)
+			}
+			if !errors.Is(got, errTimeout) {
+				t.Error("masked error does not wrap the original one")
+			}
+		})
+	}
+}
+
+func TestNewRedactorRejectsInvalidPatterns(t *testing.T) {
+	if _, err := newRedactor(nil, []string{"("}); err == nil {
+		t.Error("newRedactor accepted an invalid pattern")
+	}
+}
+
+func TestContainsFold(t *testing.T) {
+	tests := []struct {
+		s      string
+		substr string
+		want   bool
+	}{
+		{"Authorization: Bearer x", "bearer", true},
+		{"BASIC", "basic", true},
+		{"bear", "bearer", false},
+		{"no credentials", "basic", false},
+		{"", "basic", false},
+	}
+
+	for _, tt := range tests {
+		if got := containsFold(tt.s, tt.substr); got != tt.want {
+			t.Errorf("containsFold(%q, %q) = %t, want %t", tt.s, tt.substr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// maxSampledMessages bounds the number of distinct messages the sampler tracks,
+// the counters are reset when it is exceeded
+const maxSampledMessages = 10000
+
+// defaultSamplePeriod is the burst window used when a sampler has no period
+const defaultSamplePeriod = time.Second
+
+// Probe modes select how events of loggers marked with Probe are reduced
+const (
+	// ProbeModeFull logs probe events like any other event
+	ProbeModeFull = "full"
+	// ProbeModeSample applies the probe sampling options
+	ProbeModeSample = "sample"
+	// ProbeModeSuppress drops debug and info probe events, warnings and errors are kept
+	ProbeModeSuppress = "suppress"
+)
+
+// SamplerOptions describes how events of one level are sampled. Each distinct message
+// is logged Burst times per Period, after which only every Every-th occurrence is
+// logged; an Every of zero drops all occurrences past the burst.
+type SamplerOptions struct {
+	Burst  int
+	Period time.Duration
+	Every  int
+}
+
+// SamplingOptions maps level names to the sampler applied to events at that level,
+// events at levels without a sampler are never sampled
+type SamplingOptions map[string]SamplerOptions
+
+// sampler decides per level and message whether an event is logged
+type sampler struct {
+	levels map[zerolog.Level]SamplerOptions
+
+	mu       sync.Mutex
+	counters map[sampleKey]*sampleCounter
+}
+
+// sampleKey identifies the events counted together
+type sampleKey struct {
+	level   zerolog.Level
+	message string
+}
+
+// sampleCounter counts the occurrences of a message in the current burst window
+type sampleCounter struct {
+	windowStart time.Time
+	count       int
+	suppressed  int
+}
+
+// newSampler compiles the sampling options, returning nil when nothing is sampled
+func newSampler(opts SamplingOptions) (*sampler, error) {
+	if len(opts) == 0 {
+		return nil, nil
+	}
+
+	s := &sampler{
+		levels:   make(map[zerolog.Level]SamplerOptions, len(opts)),
+		counters: make(map[sampleKey]*sampleCounter),
+	}
+	for name, options := range opts {
+		level, err := parseLevel(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sampling level: %w", err)
+		}
+		if level == zerolog.FatalLevel {
+			return nil, fmt.Errorf("fatal events cannot be sampled")
+		}
+		if options.Burst < 0 || options.Every < 0 || options.Period < 0 {
+			return nil, fmt.Errorf("sampling options of level %s must not be negative", name)
+		}
+		if options.Period == 0 {
+			options.Period = defaultSamplePeriod
+		}
+		s.levels[level] = options
+	}
+	return s, nil
+}
+
+// sample reports whether an event is logged, and how many occurrences of the same
+// message were dropped since the previous one that was logged
+func (s *sampler) sample(level zerolog.Level, message string) (bool, int) {
+	if s == nil {
+		return true, 0
+	}
+	options, ok := s.levels[level]
+	if !ok {
+		return true, 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := sampleKey{level: level, message: message}
+	counter, ok := s.counters[key]
+	if !ok {
+		if len(s.counters) >= maxSampledMessages {
+			s.counters = make(map[sampleKey]*sampleCounter)
+		}
+		counter = &sampleCounter{}
+		s.counters[key] = counter
+	}
+
+	now := time.Now()
+	if now.Sub(counter.windowStart) >= options.Period {
+		counter.windowStart = now
+		counter.count = 0
+	}
+	counter.count++
+
+	past := counter.count - options.Burst
+	if past > 0 && (options.Every == 0 || past%options.Every != 0) {
+		counter.suppressed++
+		return false, 0
+	}
+	suppressed := counter.suppressed
+	counter.suppressed = 0
+	return true, suppressed
+}
+
+// sampling holds the samplers shared by a root logger and all loggers derived from it
+type sampling struct {
+	general atomic.Pointer[sampler]
+	probe   atomic.Pointer[sampler]
+}
+
+// load returns the sampler for regular or probe loggers
+func (s *sampling) load(probe bool) *sampler {
+	if probe {
+		return s.probe.Load()
+	}
+	return s.general.Load()
+}
+
+// store replaces both samplers, levels without probe options fall back to the general ones
+func (s *sampling) store(general SamplingOptions, probeMode string, probe SamplingOptions) error {
+	generalSampler, err := newSampler(general)
+	if err != nil {
+		return err
+	}
+
+	switch probeMode {
+	case ProbeModeFull:
+		probe = nil
+	case "", ProbeModeSample:
+	case ProbeModeSuppress:
+		probe = SamplingOptions{"debug": {}, "info": {}}
+	default:
+		return fmt.Errorf("unsupported probe log mode %q, expected %s, %s or %s", probeMode, ProbeModeFull, ProbeModeSample, ProbeModeSuppress)
+	}
+
+	probeSampler := generalSampler
+	if len(probe) > 0 {
+		merged := make(SamplingOptions, len(general)+len(probe))
+		for level, options := range general {
+			merged[level] = options
+		}
+		for level, options := range probe {
+			merged[level] = options
+		}
+		if probeSampler, err = newSampler(merged); err != nil {
+			return fmt.Errorf("probe %w", err)
+		}
+	}
+
+	s.general.Store(generalSampler)
+	s.probe.Store(probeSampler)
+	return nil
+}
@@ -0,0 +1,165 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestSampler(t *testing.T) {
+	tests := []struct {
+		name    string
+		options SamplerOptions
+		events  int
+		// want lists which events are logged
+		want []bool
+		// wantSuppressed is the count reported with the last logged event
+		wantSuppressed int
+	}{
+		{
+			name:    "burst only",
+			options: SamplerOptions{Burst: 2, Period: time.Hour},
+			events:  5,
+			want:    []bool{true, true, false, false, false},
+		},
+		{
+			name:           "every nth past the burst",
+			options:        SamplerOptions{Burst: 1, Period: time.Hour, Every: 2},
+			events:         6,
+			want:           []bool{true, false, true, false, true, false},
+			wantSuppressed: 1,
+		},
+		{
+			name:    "zero burst",
+			options: SamplerOptions{Burst: 0, Period: time.Hour, Every: 3},
+			events:  4,
+			want:    []bool{false, false, true, false},
+			// Two occurrences were dropped before the third one was logged
+			wantSuppressed: 2,
+		},
+		{
+			name:    "window restarts",
+			options: SamplerOptions{Burst: 1, Period: time.Nanosecond},
+			events:  3,
+			want:    []bool{true, true, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newSampler(SamplingOptions{"info": tt.options})
+			if err != nil {
+				t.Fatalf("newSampler failed: %v", err)
+			}
+
+			suppressed := 0
+			for i := range tt.events {
+				if tt.options.Period == time.Nanosecond {
+					time.Sleep(time.Microsecond)
+				}
+				logged, dropped := s.sample(zerolog.InfoLevel, "message")
+				if logged != tt.want[i] {
+					t.Errorf("event %d logged = %t, want %t", i, logged, tt.want[i])
+				}
+				if logged {
+					suppressed = dropped
+				}
+			}
+			if suppressed != tt.wantSuppressed {
+				t.Errorf("suppressed = %d, want %d", suppressed, tt.wantSuppressed)
+			}
+		})
+	}
+}
+
+func TestSamplerScope(t *testing.T) {
+	s, err := newSampler(SamplingOptions{"debug": {Burst: 1, Period: time.Hour}})
+	if err != nil {
+		t.Fatalf("newSampler failed: %v", err)
+	}
+
+	steps := []struct {
+		level   zerolog.Level
+		message string
+		want    bool
+	}{
+		{zerolog.DebugLevel, "a", true},
+		{zerolog.DebugLevel, "a", false},
+		// Messages are counted separately
+		{zerolog.DebugLevel, "b", true},
+		// Levels without a sampler are never sampled
+		{zerolog.InfoLevel, "a", true},
+		{zerolog.InfoLevel, "a", true},
+	}
+	for i, step := range steps {
+		if logged, _ := s.sample(step.level, step.message); logged != step.want {
+			t.Errorf("step %d: %s %q logged = %t, want %t", i, step.level, step.message, logged, step.want)
+		}
+	}
+
+	var nilSampler *sampler
+	if logged, _ := nilSampler.sample(zerolog.DebugLevel, "a"); !logged {
+		t.Error("a nil sampler must log every event")
+	}
+}
+
+func TestNewSamplerRejectsInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options SamplingOptions
+	}{
+		{name: "unknown level", options: SamplingOptions{"verbose": {Burst: 1}}},
+		{name: "fatal level", options: SamplingOptions{"fatal": {Burst: 1}}},
+		{name: "negative burst", options: SamplingOptions{"info": {Burst: -1}}},
+		{name: "negative period", options: SamplingOptions{"info": {Period: -time.Second}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newSampler(tt.options); err == nil {
+				t.Error("newSampler succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestSamplingProbeModes(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      string
+		probe     SamplingOptions
+		wantDebug []bool
+		wantWarn  []bool
+	}{
+		{name: "full", mode: ProbeModeFull, probe: SamplingOptions{"debug": {Burst: 1, Period: time.Hour}}, wantDebug: []bool{true, true}, wantWarn: []bool{true, true}},
+		{name: "sample", mode: ProbeModeSample, probe: SamplingOptions{"debug": {Burst: 1, Period: time.Hour}}, wantDebug: []bool{true, false}, wantWarn: []bool{true, true}},
+		{name: "suppress", mode: ProbeModeSuppress, wantDebug: []bool{false, false}, wantWarn: []bool{true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s sampling
+			if err := s.store(nil, tt.mode, tt.probe); err != nil {
+				t.Fatalf("store failed: %v", err)
+			}
+			for i := range tt.wantDebug {
+				if logged, _ := s.load(true).sample(zerolog.DebugLevel, "probe"); logged != tt.wantDebug[i] {
+					t.Errorf("debug event %d logged = %t, want %t", i, logged, tt.wantDebug[i])
+				}
+				if logged, _ := s.load(true).sample(zerolog.WarnLevel, "probe"); logged != tt.wantWarn[i] {
+					t.Errorf("warn event %d logged = %t, want %t", i, logged, tt.wantWarn[i])
+				}
+			}
+			// Regular loggers are not affected by the probe options
+			if s.load(false) != nil {
+				t.Error("general sampler set without general options")
+			}
+		})
+	}
+
+	var s sampling
+	if err := s.store(nil, "verbose", nil); err == nil {
+		t.Error("store accepted an unknown probe mode")
+	}
+}
@@ -0,0 +1,54 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// maxStackDepth bounds the number of frames captured for a stack trace
+const maxStackDepth = 32
+
+// stackSkipPrefixes are the packages whose frames are dropped from stack traces,
+// so traces start at the code that logged the error
+var stackSkipPrefixes = []string{
+	"github.com/rs/zerolog.",
+	"github.com/go-clean/platform/logger.",
+}
+
+// marshalStack captures the stack of the call logging err, it is installed as the
+// zerolog error stack marshaler and only runs for events marked with Stack
+func marshalStack(err error) any {
+	if err == nil {
+		return nil
+	}
+
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	stack := make([]string, 0, n)
+	for {
+		frame, more := frames.Next()
+		if !skipFrame(frame.Function) {
+			stack = append(stack, fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line))
+		}
+		if !more {
+			break
+		}
+	}
+	return stack
+}
+
+// skipFrame reports whether a frame belongs to the logging machinery or the runtime
+func skipFrame(function string) bool {
+	if strings.HasPrefix(function, "runtime.") {
+		return true
+	}
+	for _, prefix := range stackSkipPrefixes {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/http/response"
+	"github.com/go-clean/platform/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute is the route label used for requests that did not match any route,
+// so that scanners hitting random paths cannot blow up label cardinality
+const unmatchedRoute = "unmatched"
+
+// Registry holds the Prometheus registry and the HTTP RED metrics of the service
+type Registry struct {
+	registry *prometheus.Registry
+	config   config.MetricsConfig
+	logger   logger.Logger
+
+	requestsTotal    *prometheus.CounterVec
+	requestDuration  *prometheus.HistogramVec
+	requestsInFlight prometheus.Gauge
+}
+
+// NewRegistry creates a new metrics registry with Go runtime, process and HTTP metrics
+func NewRegistry(cfg config.MetricsConfig, log logger.Logger) (*Registry, error) {
+	log.Info().Bool("enabled", cfg.Enabled).Str("path", cfg.Path).Msg("Initializing metrics registry")
+
+	r := &Registry{
+		registry: prometheus.NewRegistry(),
+		config:   cfg,
+		logger:   log,
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.Namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Total number of HTTP requests by method, route pattern and status code.",
+		}, []string{"method", "route", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.Namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by method, route pattern and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		requestsInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: cfg.Namespace,
+			Subsystem: "http",
+			Name:      "requests_in_flight",
+			Help:      "Number of HTTP requests currently being served.",
+		}),
+	}
+
+	if err := r.Register(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		r.requestsTotal,
+		r.requestDuration,
+		r.requestsInFlight,
+	); err != nil {
+		log.Error().Err(err).Msg("Failed to register default metrics")
+		return nil, err
+	}
+
+	log.Info().Msg("Metrics registry initialized successfully")
+	return r, nil
+}
+
+// Enabled reports whether metrics are collected and exposed over HTTP
+func (r *Registry) Enabled() bool {
+	return r.config.Enabled
+}
+
+// Namespace returns the metric namespace collectors should use
+func (r *Registry) Namespace() string {
+	return r.config.Namespace
+}
+
+// Register registers collectors with the registry
+func (r *Registry) Register(cs ...prometheus.Collector) error {
+	for _, c := range cs {
+		if err := r.registry.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Middleware records request count, latency and in-flight requests labelled
+// with the matched route pattern rather than the raw path
+func (r *Registry) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		self := c.Route()
+		r.requestsInFlight.Inc()
+		defer r.requestsInFlight.Dec()
+
+		err := c.Next()
+
+		status := response.Status(c, err)
+
+		labels := prometheus.Labels{
+			"method": c.Method(),
+			"route":  routePattern(c, self),
+			"status": strconv.Itoa(status),
+		}
+		r.requestsTotal.With(labels).Inc()
+		r.requestDuration.With(labels).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// routePattern returns the pattern of the route that handled the request
+func routePattern(c *fiber.Ctx, middleware *fiber.Route) string {
+	route := c.Route()
+	// Without a matching route the context still points at the middleware itself
+	if route == nil || route == middleware {
+		return unmatchedRoute
+	}
+	return route.Path
+}
+
+// Handler returns the fiber handler serving metrics in the Prometheus text format
+func (r *Registry) Handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{}))
+}
+
+// RegisterRoutes registers the metrics endpoint when metrics are enabled
+func (r *Registry) RegisterRoutes(app *fiber.App) {
+	if !r.Enabled() {
+		r.logger.Info().Msg("Metrics disabled, skipping route registration")
+		return
+	}
+	r.logger.Info().Msg("Registering metrics routes")
+	app.Get(r.config.Path, r.Handler())
+	r.logger.Debug().Str("route", r.config.Path).Msg("Metrics route registered")
+}
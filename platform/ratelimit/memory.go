@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepThreshold is the number of keys above which expired keys are removed
+const sweepThreshold = 10000
+
+// MemoryStore keeps arrival times in process memory, so limits apply per replica
+type MemoryStore struct {
+	mu   sync.Mutex
+	tats map[string]time.Time
+	now  func() time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		tats: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+// Allow applies one request of key to the policy
+func (s *MemoryStore) Allow(_ context.Context, key string, policy Policy) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if len(s.tats) >= sweepThreshold {
+		s.sweep(now)
+	}
+
+	result, tat := gcra(now, s.tats[key], policy)
+	if result.Allowed {
+		s.tats[key] = tat
+	}
+	return result, nil
+}
+
+// sweep removes keys whose bucket is full again, must be called with mu held
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, tat := range s.tats {
+		if !tat.After(now) {
+			delete(s.tats, key)
+		}
+	}
+}
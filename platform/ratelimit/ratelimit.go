@@ -0,0 +1,145 @@
+// Package ratelimit implements the generic cell rate algorithm (GCRA), a token
+// bucket that stores a single timestamp per key, over shared and local stores.
+package ratelimit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-clean/platform/logger"
+)
+
+// Policy describes how many requests a key may make
+type Policy struct {
+	// RequestsPerMinute is the sustained rate at which requests are admitted
+	RequestsPerMinute int
+	// Burst is the number of requests admitted at once before the rate applies, at least one
+	Burst int
+}
+
+// Interval returns the time it takes to earn one request at the sustained rate
+func (p Policy) Interval() time.Duration {
+	if p.RequestsPerMinute <= 0 {
+		return time.Minute
+	}
+	return time.Minute / time.Duration(p.RequestsPerMinute)
+}
+
+// Capacity returns the number of requests admitted at once
+func (p Policy) Capacity() int {
+	if p.Burst < 1 {
+		return 1
+	}
+	return p.Burst
+}
+
+// Result describes the decision for a single request
+type Result struct {
+	Allowed bool
+	// Limit is the capacity of the policy
+	Limit int
+	// Remaining is the number of requests that would be admitted right now
+	Remaining int
+	// RetryAfter is how long until the next request is admitted, zero when allowed
+	RetryAfter time.Duration
+	// ResetAfter is how long until the full capacity is available again
+	ResetAfter time.Duration
+}
+
+// Store admits or rejects requests of a key according to a policy
+type Store interface {
+	Allow(ctx context.Context, key string, policy Policy) (Result, error)
+}
+
+// gcra applies one request to the theoretical arrival time tat of a key, returning
+// the decision and the new arrival time to store when the request is allowed
+func gcra(now, tat time.Time, policy Policy) (Result, time.Time) {
+	interval := policy.Interval()
+	capacity := policy.Capacity()
+
+	if tat.Before(now) {
+		tat = now
+	}
+	next := tat.Add(interval)
+	// The earliest time the request fits into the burst
+	allowAt := next.Add(-interval * time.Duration(capacity))
+
+	if now.Before(allowAt) {
+		return Result{
+			Allowed:    false,
+			Limit:      capacity,
+			RetryAfter: allowAt.Sub(now),
+			ResetAfter: tat.Sub(now),
+		}, tat
+	}
+	return Result{
+		Allowed:    true,
+		Limit:      capacity,
+		Remaining:  int(now.Sub(allowAt) / interval),
+		ResetAfter: next.Sub(now),
+	}, next
+}
+
+// fallbackCooldown is how long the primary store is skipped after it fails, so a
+// Redis outage does not add a failing round trip to every request
+const fallbackCooldown = 5 * time.Second
+
+// FallbackStore uses a primary store, such as Redis shared by every replica, and
+// falls back to a secondary store, such as memory, whenever the primary fails.
+// After a failure the primary store is skipped for a cooldown, then a single
+// request probes it again.
+type FallbackStore struct {
+	primary  Store
+	fallback Store
+	logger   logger.Logger
+	cooldown time.Duration
+	// failedAt is the Unix time in nanoseconds of the last primary failure or
+	// probe, zero while the primary store is healthy
+	failedAt atomic.Int64
+}
+
+// NewFallbackStore creates a store falling back to fallback when primary fails
+func NewFallbackStore(primary, fallback Store, log logger.Logger) *FallbackStore {
+	return &FallbackStore{
+		primary:  primary,
+		fallback: fallback,
+		logger:   log,
+		cooldown: fallbackCooldown,
+	}
+}
+
+// Allow asks the primary store, and the fallback store while the primary one
+// fails. Entering and leaving the fallback is logged once per transition.
+func (s *FallbackStore) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	if !s.usePrimary(time.Now()) {
+		return s.fallback.Allow(ctx, key, policy)
+	}
+
+	result, err := s.primary.Allow(ctx, key, policy)
+	if err != nil {
+		if s.failedAt.Swap(time.Now().UnixNano()) == 0 {
+			s.logger.FromContext(ctx).Warn().Err(err).Dur("cooldown_ms", s.cooldown).
+				Msg("Rate limit store failed, using in-memory fallback")
+		}
+		return s.fallback.Allow(ctx, key, policy)
+	}
+	if s.failedAt.Swap(0) != 0 {
+		s.logger.FromContext(ctx).Info().Msg("Rate limit store recovered, leaving in-memory fallback")
+	}
+	return result, nil
+}
+
+// usePrimary reports whether the primary store should be asked: always while it is
+// healthy, and by a single caller once the cooldown after a failure has passed
+func (s *FallbackStore) usePrimary(now time.Time) bool {
+	failedAt := s.failedAt.Load()
+	if failedAt == 0 {
+		return true
+	}
+	if now.Sub(time.Unix(0, failedAt)) < s.cooldown {
+		return false
+	}
+	// Restart the cooldown so concurrent requests keep using the fallback during the probe
+	return s.failedAt.CompareAndSwap(failedAt, now.UnixNano())
+}
@@ -0,0 +1,164 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-clean/platform/logger/logtest"
+)
+
+func TestGCRA(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// One request per second with a burst of three
+	policy := Policy{RequestsPerMinute: 60, Burst: 3}
+
+	tests := []struct {
+		name string
+		// offsets are the times of the requests since start
+		offsets []time.Duration
+		want    Result
+	}{
+		{
+			name:    "first request",
+			offsets: []time.Duration{0},
+			want:    Result{Allowed: true, Limit: 3, Remaining: 2, ResetAfter: time.Second},
+		},
+		{
+			name:    "burst used up",
+			offsets: []time.Duration{0, 0, 0},
+			want:    Result{Allowed: true, Limit: 3, Remaining: 0, ResetAfter: 3 * time.Second},
+		},
+		{
+			name:    "past the burst",
+			offsets: []time.Duration{0, 0, 0, 0},
+			want:    Result{Allowed: false, Limit: 3, RetryAfter: time.Second, ResetAfter: 3 * time.Second},
+		},
+		{
+			name:    "retry after partially elapsed",
+			offsets: []time.Duration{0, 0, 0, 400 * time.Millisecond},
+			want:    Result{Allowed: false, Limit: 3, RetryAfter: 600 * time.Millisecond, ResetAfter: 2600 * time.Millisecond},
+		},
+		{
+			name:    "one request earned back",
+			offsets: []time.Duration{0, 0, 0, time.Second},
+			want:    Result{Allowed: true, Limit: 3, Remaining: 0, ResetAfter: 3 * time.Second},
+		},
+		{
+			name:    "idle bucket refills completely",
+			offsets: []time.Duration{0, 0, 0, time.Hour},
+			want:    Result{Allowed: true, Limit: 3, Remaining: 2, ResetAfter: time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tat time.Time
+			var result Result
+			for _, offset := range tt.offsets {
+				var next time.Time
+				result, next = gcra(start.Add(offset), tat, policy)
+				if result.Allowed {
+					tat = next
+				}
+			}
+			if result != tt.want {
+				t.Errorf("result = %+v, want %+v", result, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyDefaults(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       Policy
+		wantInterval time.Duration
+		wantCapacity int
+	}{
+		{name: "configured", policy: Policy{RequestsPerMinute: 120, Burst: 10}, wantInterval: 500 * time.Millisecond, wantCapacity: 10},
+		{name: "no rate", policy: Policy{}, wantInterval: time.Minute, wantCapacity: 1},
+		{name: "negative burst", policy: Policy{RequestsPerMinute: 60, Burst: -1}, wantInterval: time.Second, wantCapacity: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Interval(); got != tt.wantInterval {
+				t.Errorf("Interval() = %s, want %s", got, tt.wantInterval)
+			}
+			if got := tt.policy.Capacity(); got != tt.wantCapacity {
+				t.Errorf("Capacity() = %d, want %d", got, tt.wantCapacity)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreKeysAreIndependent(t *testing.T) {
+	store := NewMemoryStore()
+	policy := Policy{RequestsPerMinute: 60, Burst: 1}
+	ctx := context.Background()
+
+	for _, step := range []struct {
+		key  string
+		want bool
+	}{
+		{"a", true},
+		{"a", false},
+		{"b", true},
+	} {
+		result, err := store.Allow(ctx, step.key, policy)
+		if err != nil {
+			t.Fatalf("Allow(%s) failed: %v", step.key, err)
+		}
+		if result.Allowed != step.want {
+			t.Errorf("Allow(%s).Allowed = %t, want %t", step.key, result.Allowed, step.want)
+		}
+	}
+}
+
+// stubStore returns a fixed result, or err when set
+type stubStore struct {
+	result Result
+	err    error
+	calls  int
+}
+
+func (s *stubStore) Allow(context.Context, string, Policy) (Result, error) {
+	s.calls++
+	return s.result, s.err
+}
+
+func TestFallbackStore(t *testing.T) {
+	log := logtest.New()
+	primary := &stubStore{result: Result{Allowed: true, Limit: 1}, err: errors.New("redis down")}
+	fallback := &stubStore{result: Result{Allowed: true, Limit: 2}}
+	store := NewFallbackStore(primary, fallback, log)
+	store.cooldown = time.Hour
+	ctx := context.Background()
+
+	for range 3 {
+		result, err := store.Allow(ctx, "key", Policy{})
+		if err != nil {
+			t.Fatalf("Allow failed: %v", err)
+		}
+		if result.Limit != 2 {
+			t.Fatalf("result from limit %d, want the fallback", result.Limit)
+		}
+	}
+	if primary.calls != 1 {
+		t.Errorf("primary called %d times during the cooldown, want 1", primary.calls)
+	}
+	if got := len(log.Find(logtest.Level(logtest.LevelWarn))); got != 1 {
+		t.Errorf("logged %d warnings, want 1", got)
+	}
+
+	// Once the cooldown passed, a recovered primary is used again
+	primary.err = nil
+	store.cooldown = 0
+	result, _ := store.Allow(ctx, "key", Policy{})
+	if result.Limit != 1 {
+		t.Errorf("result from limit %d, want the primary", result.Limit)
+	}
+	log.AssertLogged(t, logtest.Level(logtest.LevelInfo), logtest.MessageContains("recovered"))
+}
@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gcraScript applies one request atomically using the Redis clock, so every
+// replica shares the same time source. The arrival time is stored in microseconds
+// and expires once the bucket is full again.
+// ARGV: emission interval in microseconds, capacity
+// Returns: allowed (0/1), remaining, retry after and reset after in microseconds
+var gcraScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local capacity = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local tat = now
+local stored = redis.call('GET', KEYS[1])
+if stored then
+	tat = math.max(tonumber(stored), now)
+end
+
+local next = tat + interval
+local allow_at = next - interval * capacity
+if now < allow_at then
+	return {0, 0, allow_at - now, tat - now}
+end
+
+redis.call('SET', KEYS[1], string.format('%.0f', next), 'PX', math.ceil((next - now) / 1000))
+return {1, math.floor((now - allow_at) / interval), 0, next - now}
+`)
+
+// RedisStore keeps arrival times in Redis, so limits apply across every replica
+type RedisStore struct {
+	client *redis.Client
+	// timeout bounds a single script call, a slow Redis must not hold requests
+	// longer than serving them from the in-memory fallback would
+	timeout time.Duration
+}
+
+// NewRedisStore creates a store backed by the Redis client, whose calls fail after timeout
+func NewRedisStore(client *redis.Client, timeout time.Duration) *RedisStore {
+	return &RedisStore{client: client, timeout: timeout}
+}
+
+// Allow applies one request of key to the policy, failing after the store timeout
+func (s *RedisStore) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	values, err := gcraScript.Run(ctx, s.client, []string{key},
+		policy.Interval().Microseconds(), policy.Capacity()).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to apply rate limit: %w", err)
+	}
+	if len(values) != 4 {
+		return Result{}, fmt.Errorf("unexpected rate limit script result %v", values)
+	}
+	return Result{
+		Allowed:    values[0] == 1,
+		Limit:      policy.Capacity(),
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Microsecond,
+		ResetAfter: time.Duration(values[3]) * time.Microsecond,
+	}, nil
+}
@@ -30,6 +30,7 @@ func NewClient(cfg config.RedisConfig, log logger.Logger) (*redis.Client, error)
 	// Create Redis client
 	log.Debug().Int("pool_size", cfg.PoolSize).Int("min_idle_conns", cfg.MinIdleConns).Msg("Creating Redis client")
 	client := redis.NewClient(opts)
+	client.AddHook(NewTracingHook(opts.Addr))
 
 	// Test the connection
 	log.Debug().Msg("Testing Redis connection")
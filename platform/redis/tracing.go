@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/go-clean/platform/tracing"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingHook implements redis.Hook, recording a client span for every command and pipeline
+type TracingHook struct {
+	addr string
+}
+
+// NewTracingHook creates a new Redis tracing hook
+func NewTracingHook(addr string) *TracingHook {
+	return &TracingHook{addr: addr}
+}
+
+// DialHook records a span for establishing new connections
+func (h *TracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		ctx, span := h.start(ctx, "redis.dial")
+		defer span.End()
+
+		conn, err := next(ctx, network, addr)
+		recordError(span, err)
+		return conn, err
+	}
+}
+
+// ProcessHook records a span for a single command
+func (h *TracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		ctx, span := h.start(ctx, "redis."+cmd.Name(), semconv.DBOperationName(cmd.Name()))
+		defer span.End()
+
+		err := next(ctx, cmd)
+		recordError(span, err)
+		return err
+	}
+}
+
+// ProcessPipelineHook records a span for a pipeline of commands
+func (h *TracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		ctx, span := h.start(ctx, "redis.pipeline",
+			semconv.DBOperationName("pipeline"),
+			attribute.Int("db.operation.batch.size", len(cmds)),
+		)
+		defer span.End()
+
+		err := next(ctx, cmds)
+		recordError(span, err)
+		return err
+	}
+}
+
+// start starts a client span with the common Redis attributes
+func (h *TracingHook) start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, semconv.DBSystemNameRedis, semconv.ServerAddress(h.addr))
+	return tracing.Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// recordError marks the span as failed, a missing key is not an error
+func recordError(span trace.Span, err error) {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/go-clean/platform/http/response"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headerCarrier adapts the fiber request headers to a propagation.TextMapCarrier
+type headerCarrier struct {
+	c *fiber.Ctx
+}
+
+// Get returns the value of a request header
+func (hc headerCarrier) Get(key string) string {
+	return hc.c.Get(key)
+}
+
+// Set sets a request header
+func (hc headerCarrier) Set(key, value string) {
+	hc.c.Request().Header.Set(key, value)
+}
+
+// Keys returns the request header names
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0)
+	hc.c.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
+
+// Middleware extracts the W3C traceparent header, starts a server span for
+// every request and stores it in the user context, so handlers must pass
+// c.UserContext() down to the application layer
+func (p *Provider) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		self := c.Route()
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), headerCarrier{c: c})
+
+		ctx, span := Tracer().Start(ctx, c.Method(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Method()),
+				semconv.URLPath(c.Path()),
+			),
+		)
+		defer span.End()
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+
+		status := response.Status(c, err)
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		// Name the span after the route pattern once routing is known
+		if route := c.Route(); route != nil && route != self {
+			span.SetName(c.Method() + " " + route.Path)
+			span.SetAttributes(semconv.HTTPRoute(route.Path))
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,151 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-clean/platform/config"
+	"github.com/go-clean/platform/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer used by the application
+const InstrumentationName = "github.com/go-clean"
+
+// Supported span exporters
+const (
+	ExporterOTLPGRPC = "otlp-grpc"
+	ExporterOTLPHTTP = "otlp-http"
+	ExporterStdout   = "stdout"
+)
+
+// Provider owns the OpenTelemetry tracer provider of the application
+type Provider struct {
+	provider *sdktrace.TracerProvider
+	config   config.TracingConfig
+	logger   logger.Logger
+}
+
+// NewProvider creates the tracer provider and installs it, together with the
+// W3C trace context propagator, as the global OpenTelemetry provider. When
+// tracing is disabled the global no-op provider is kept, so instrumentation
+// stays in place at negligible cost.
+func NewProvider(cfg config.TracingConfig, app config.AppConfig, log logger.Logger) (*Provider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !cfg.Enabled {
+		log.Info().Msg("Tracing disabled")
+		return &Provider{config: cfg, logger: log}, nil
+	}
+
+	log.Info().Str("exporter", cfg.Exporter).Str("endpoint", cfg.Endpoint).Msg("Initializing tracing")
+
+	exporter, err := newExporter(cfg)
+	if err != nil {
+		log.Error().Err(err).Str("exporter", cfg.Exporter).Msg("Failed to create span exporter")
+		return nil, fmt.Errorf("failed to create span exporter: %w", err)
+	}
+
+	res, err := resource.New(context.Background(),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(app.Name),
+			semconv.ServiceVersion(app.Version),
+			semconv.DeploymentEnvironmentName(app.Environment),
+		),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create tracing resource")
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.Info().Msg("Tracing initialized successfully")
+	return &Provider{
+		provider: provider,
+		config:   cfg,
+		logger:   log,
+	}, nil
+}
+
+// newExporter creates the span exporter selected in the configuration
+func newExporter(cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	ctx := context.Background()
+
+	switch strings.ToLower(cfg.Exporter) {
+	case ExporterOTLPGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ExporterOTLPHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter %q", cfg.Exporter)
+	}
+}
+
+// Enabled reports whether spans are recorded and exported
+func (p *Provider) Enabled() bool {
+	return p.provider != nil
+}
+
+// Shutdown flushes pending spans and stops the exporter
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if p.provider == nil {
+		return nil
+	}
+
+	p.logger.Info().Msg("Shutting down tracing")
+	if err := p.provider.Shutdown(ctx); err != nil {
+		p.logger.Error().Err(err).Msg("Failed to flush pending spans")
+		return err
+	}
+	p.logger.Info().Msg("Tracing shutdown completed")
+	return nil
+}
+
+// Tracer returns the application tracer
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// StartSpan starts an internal span as a child of the span in ctx
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// RecordError records err on the span and marks it as failed
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
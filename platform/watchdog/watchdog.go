@@ -0,0 +1,118 @@
+package watchdog
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-clean/platform/logger"
+)
+
+// Stall describes a registered component whose heartbeat missed its deadline
+type Stall struct {
+	Name          string
+	Timeout       time.Duration
+	LastHeartbeat time.Time
+}
+
+// Watchdog tracks heartbeats of long-running components such as workers and
+// pollers so that a wedged component can be detected by the liveness probe
+type Watchdog struct {
+	logger     logger.Logger
+	mu         sync.RWMutex
+	heartbeats map[string]*Heartbeat
+}
+
+// New creates a new watchdog
+func New(log logger.Logger) *Watchdog {
+	return &Watchdog{
+		logger:     log,
+		heartbeats: make(map[string]*Heartbeat),
+	}
+}
+
+// Register registers a component that must call Beat at least once per timeout.
+// The deadline starts at registration, so the first beat is due within timeout.
+func (w *Watchdog) Register(name string, timeout time.Duration) (*Heartbeat, error) {
+	if name == "" {
+		return nil, fmt.Errorf("heartbeat name must not be empty")
+	}
+	if timeout <= 0 {
+		return nil, fmt.Errorf("heartbeat %q timeout must be positive", name)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, exists := w.heartbeats[name]; exists {
+		return nil, fmt.Errorf("heartbeat %q is already registered", name)
+	}
+
+	heartbeat := &Heartbeat{
+		name:     name,
+		timeout:  timeout,
+		watchdog: w,
+	}
+	heartbeat.Beat()
+	w.heartbeats[name] = heartbeat
+
+	w.logger.Debug().Str("component", name).Dur("timeout_ms", timeout).Msg("Heartbeat registered")
+	return heartbeat, nil
+}
+
+// Stalled returns the registered components whose last heartbeat is older than their timeout, sorted by name
+func (w *Watchdog) Stalled(now time.Time) []Stall {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	var stalls []Stall
+	for _, heartbeat := range w.heartbeats {
+		last := heartbeat.LastBeat()
+		if now.Sub(last) > heartbeat.timeout {
+			stalls = append(stalls, Stall{
+				Name:          heartbeat.name,
+				Timeout:       heartbeat.timeout,
+				LastHeartbeat: last,
+			})
+		}
+	}
+
+	sort.Slice(stalls, func(i, j int) bool { return stalls[i].Name < stalls[j].Name })
+	return stalls
+}
+
+// unregister removes a heartbeat from the watchdog
+func (w *Watchdog) unregister(heartbeat *Heartbeat) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.heartbeats[heartbeat.name] == heartbeat {
+		delete(w.heartbeats, heartbeat.name)
+		w.logger.Debug().Str("component", heartbeat.name).Msg("Heartbeat unregistered")
+	}
+}
+
+// Heartbeat is the handle a registered component uses to signal progress
+type Heartbeat struct {
+	name     string
+	timeout  time.Duration
+	last     atomic.Int64
+	watchdog *Watchdog
+}
+
+// Beat records that the component is making progress
+func (h *Heartbeat) Beat() {
+	h.last.Store(time.Now().UnixNano())
+}
+
+// LastBeat returns when the component last signalled progress
+func (h *Heartbeat) LastBeat() time.Time {
+	return time.Unix(0, h.last.Load()).UTC()
+}
+
+// Stop unregisters the heartbeat, used when the component shuts down cleanly
+func (h *Heartbeat) Stop() {
+	h.watchdog.unregister(h)
+}
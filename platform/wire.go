@@ -1,46 +1,163 @@
 package platform
 
 import (
+	"os"
+
 	"github.com/go-clean/platform/config"
 	"github.com/go-clean/platform/database"
+	platformGrpc "github.com/go-clean/platform/grpc"
 	"github.com/go-clean/platform/http"
 	"github.com/go-clean/platform/logger"
+	"github.com/go-clean/platform/metrics"
+	"github.com/go-clean/platform/ratelimit"
 	platformRedis "github.com/go-clean/platform/redis"
+	"github.com/go-clean/platform/tracing"
+	"github.com/go-clean/platform/watchdog"
 	"github.com/google/wire"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 )
 
-// ProvideLogger provides a logger instance
+// ProvideLogger provides the bootstrap logger, reconfigured by ProvideConfig once
+// the logging configuration is loaded. It already honors the level, format and
+// output environment variables, so configuration loading is logged as configured.
 func ProvideLogger() logger.Logger {
-	return logger.New()
+	log, err := logger.NewWithOptions(logger.Options{
+		Level:  os.Getenv("GO_CLEAN_LOGGING_LEVEL"),
+		Format: os.Getenv("GO_CLEAN_LOGGING_FORMAT"),
+		Output: os.Getenv("GO_CLEAN_LOGGING_OUTPUT"),
+	})
+	if err != nil {
+		// Invalid values are reported by configuration validation
+		log = logger.New()
+		log.Warn().Err(err).Msg("Ignoring invalid logging environment variables while bootstrapping")
+	}
+	return log
+}
+
+// ProvideConfig provides a configuration instance and applies its logging section
+// to the shared logger
+func ProvideConfig(log logger.Logger, path config.Path) (*config.Config, error) {
+	cfg, err := config.Load(log, path)
+	if err != nil {
+		return nil, err
+	}
+	if configurable, ok := log.(logger.Reconfigurable); ok {
+		if err := configurable.Configure(logger.Options{
+			Level:          cfg.Logging.Level,
+			Format:         cfg.Logging.Format,
+			Output:         cfg.Logging.Output,
+			RedactKeys:     cfg.Logging.RedactKeys,
+			RedactPatterns: cfg.Logging.RedactPatterns,
+			Sampling:       samplingOptions(cfg.Logging.Sampling),
+			ProbeMode:      cfg.Logging.Probes.Mode,
+			ProbeSampling:  samplingOptions(cfg.Logging.Probes.Sampling),
+		}); err != nil {
+			log.Error().Err(err).Msg("Failed to apply logging configuration")
+			return nil, err
+		}
+	}
+	log.Debug().Str("log_level", cfg.Logging.Level).Str("format", cfg.Logging.Format).Str("output", cfg.Logging.Output).Msg("Logging configured")
+	// Dumped once the configured level applies, so they only appear when asked for
+	log.Debug().Any("sources", cfg.Sources()).Msg("Configuration value sources")
+	log.Debug().Any("config", cfg.Redacted()).Msg("Configuration")
+	return cfg, nil
+}
+
+// samplingOptions converts sampler configuration into logger sampling options
+func samplingOptions(samplers map[string]config.SamplerConfig) logger.SamplingOptions {
+	options := make(logger.SamplingOptions, len(samplers))
+	for level, sampler := range samplers {
+		options[level] = logger.SamplerOptions{
+			Burst:  sampler.Burst,
+			Period: sampler.Period,
+			Every:  sampler.Every,
+		}
+	}
+	return options
+}
+
+// ProvideLevelController provides the controller of the shared logger's levels
+func ProvideLevelController(log logger.Logger) (*logger.LevelController, error) {
+	if controllable, ok := log.(logger.Controllable); ok {
+		return controllable.Levels(), nil
+	}
+	// Loggers without runtime level control get a detached controller
+	return logger.NewLevelController("info")
 }
 
-// ProvideConfig provides a configuration instance
-func ProvideConfig(log logger.Logger) (*config.Config, error) {
-	return config.Load(log)
+// ProvideMetrics provides the Prometheus metrics registry
+func ProvideMetrics(cfg *config.Config, log logger.Logger) (*metrics.Registry, error) {
+	return metrics.NewRegistry(cfg.Metrics, log.Named("metrics"))
 }
 
-// ProvideDatabase provides a database connection pool
-func ProvideDatabase(cfg *config.Config, log logger.Logger) (*pgxpool.Pool, error) {
-	return database.NewConnection(cfg.Database, log)
+// ProvideTracing provides the OpenTelemetry tracer provider
+func ProvideTracing(cfg *config.Config, log logger.Logger) (*tracing.Provider, error) {
+	return tracing.NewProvider(cfg.Tracing, cfg.App, log.Named("tracing"))
 }
 
-// ProvideRedis provides a Redis client
-func ProvideRedis(cfg *config.Config, log logger.Logger) (*redis.Client, error) {
-	return platformRedis.NewClient(cfg.Redis, log)
+// ProvideDatabase provides a database connection pool with its pool metrics registered
+func ProvideDatabase(cfg *config.Config, log logger.Logger, registry *metrics.Registry) (*pgxpool.Pool, error) {
+	pool, err := database.NewConnection(cfg.Database, log.Named("database"))
+	if err != nil {
+		return nil, err
+	}
+	if err := registry.Register(database.NewPoolCollector(pool, registry.Namespace())); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return pool, nil
+}
+
+// ProvideRedis provides a Redis client with its pool metrics registered
+func ProvideRedis(cfg *config.Config, log logger.Logger, registry *metrics.Registry) (*redis.Client, error) {
+	client, err := platformRedis.NewClient(cfg.Redis, log.Named("redis"))
+	if err != nil {
+		return nil, err
+	}
+	if err := registry.Register(platformRedis.NewPoolCollector(client, registry.Namespace())); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return client, nil
 }
 
 // ProvideHTTPServer provides an HTTP server instance
-func ProvideHTTPServer(cfg *config.Config, log logger.Logger) *http.Server {
-	return http.NewServer(cfg.Server.Port, log)
+func ProvideHTTPServer(cfg *config.Config, log logger.Logger, registry *metrics.Registry, tracer *tracing.Provider, limiter ratelimit.Store) *http.Server {
+	return http.NewServer(cfg.Server, log.Named("http"), registry, tracer, cfg.Logging, cfg.CORS, cfg.RateLimit, limiter)
+}
+
+// ProvideRateLimitStore provides the rate limit store, Redis shared by every replica
+// with an in-memory fallback, or memory only
+func ProvideRateLimitStore(cfg *config.Config, client *redis.Client, log logger.Logger) ratelimit.Store {
+	memory := ratelimit.NewMemoryStore()
+	if cfg.RateLimit.Store == "memory" {
+		return memory
+	}
+	return ratelimit.NewFallbackStore(ratelimit.NewRedisStore(client, cfg.RateLimit.RedisTimeout), memory, log.Named("ratelimit"))
+}
+
+// ProvideWatchdog provides the heartbeat watchdog shared by long-running components
+func ProvideWatchdog(log logger.Logger) *watchdog.Watchdog {
+	return watchdog.New(log.Named("watchdog"))
+}
+
+// ProvideGRPCServer provides a gRPC server instance
+func ProvideGRPCServer(cfg *config.Config, log logger.Logger) *platformGrpc.Server {
+	return platformGrpc.NewServer(cfg.GRPC, log.Named("grpc"))
 }
 
 // PlatformSet is a wire provider set for all platform dependencies
 var PlatformSet = wire.NewSet(
 	ProvideLogger,
 	ProvideConfig,
+	ProvideLevelController,
+	ProvideMetrics,
+	ProvideTracing,
 	ProvideDatabase,
 	ProvideRedis,
 	ProvideHTTPServer,
+	ProvideRateLimitStore,
+	ProvideGRPCServer,
+	ProvideWatchdog,
 )